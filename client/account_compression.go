@@ -0,0 +1,16 @@
+package client
+
+import (
+	"context"
+
+	"github.com/qimeila/solana-go-sdk/program/account_compression"
+)
+
+// GetConcurrentMerkleTreeAccount fetches a compressed nft tree
+func (c *Client) GetConcurrentMerkleTreeAccount(ctx context.Context, base58Addr string) (account_compression.ConcurrentMerkleTreeAccount, error) {
+	accountInfo, err := c.GetAccountInfo(ctx, base58Addr)
+	if err != nil {
+		return account_compression.ConcurrentMerkleTreeAccount{}, err
+	}
+	return account_compression.DeserializeConcurrentMerkleTreeAccount(accountInfo.Data, accountInfo.Owner)
+}
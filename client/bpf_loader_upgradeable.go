@@ -0,0 +1,466 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/qimeila/solana-go-sdk/common"
+	"github.com/qimeila/solana-go-sdk/program/bpf_loader_upgradeable"
+	"github.com/qimeila/solana-go-sdk/program/system"
+	"github.com/qimeila/solana-go-sdk/rpc"
+	"github.com/qimeila/solana-go-sdk/types"
+)
+
+const (
+	// packetDataSize is the max size of a serialized transaction
+	packetDataSize = 1232
+
+	defaultProgramWriteConcurrency = 16
+	defaultProgramWriteMaxRounds   = 5
+
+	maxSignatureStatusesPerRequest = 256
+)
+
+var transactionConfirmPollInterval = time.Second
+
+// GetUpgradeableLoaderState fetches a buffer, program or program data account of the upgradeable loader
+func (c *Client) GetUpgradeableLoaderState(ctx context.Context, base58Addr string) (bpf_loader_upgradeable.UpgradeableLoaderState, error) {
+	accountInfo, err := c.GetAccountInfo(ctx, base58Addr)
+	if err != nil {
+		return bpf_loader_upgradeable.UpgradeableLoaderState{}, err
+	}
+	return bpf_loader_upgradeable.DeserializeUpgradeableLoaderState(accountInfo.Data, accountInfo.Owner)
+}
+
+type WriteProgramBufferParam struct {
+	Payer types.Account
+	// Buffer is created when it doesn't exist, write to the same buffer again to resume an interrupted write
+	Buffer types.Account
+	// Authority is the buffer authority, default is Payer
+	Authority *types.Account
+	ELF       []byte
+	// Concurrency is the number of write transactions in flight, default is 16
+	Concurrency int
+	// MaxRounds is how many times chunks which didn't land are resent, default is 5
+	MaxRounds int
+}
+
+// WriteProgramBuffer writes an ELF into a buffer account. Only the chunks which differ from the buffer's
+// content are sent, so calling it again with the same buffer resumes a failed write.
+func (c *Client) WriteProgramBuffer(ctx context.Context, param WriteProgramBufferParam) error {
+	authority := param.Payer
+	if param.Authority != nil {
+		authority = *param.Authority
+	}
+	concurrency := param.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultProgramWriteConcurrency
+	}
+	maxRounds := param.MaxRounds
+	if maxRounds <= 0 {
+		maxRounds = defaultProgramWriteMaxRounds
+	}
+
+	bufferData, err := c.prepareProgramBuffer(ctx, param.Payer, param.Buffer, authority, uint64(len(param.ELF)))
+	if err != nil {
+		return err
+	}
+
+	chunkSize, err := programWriteChunkSize(param.Payer.PublicKey, param.Buffer.PublicKey, authority.PublicKey)
+	if err != nil {
+		return err
+	}
+
+	for round := 0; ; round++ {
+		if round > 0 {
+			state, err := c.GetUpgradeableLoaderState(ctx, param.Buffer.PublicKey.ToBase58())
+			if err != nil {
+				return fmt.Errorf("failed to get buffer account, err: %w", err)
+			}
+			if len(state.Data) != len(param.ELF) {
+				return fmt.Errorf("buffer size mismatch, expected: %v, got: %v", len(param.ELF), len(state.Data))
+			}
+			bufferData = state.Data
+		}
+
+		offsets := make([]int, 0)
+		for offset := 0; offset < len(param.ELF); offset += chunkSize {
+			end := offset + chunkSize
+			if end > len(param.ELF) {
+				end = len(param.ELF)
+			}
+			if !bytes.Equal(bufferData[offset:end], param.ELF[offset:end]) {
+				offsets = append(offsets, offset)
+			}
+		}
+		if len(offsets) == 0 {
+			return nil
+		}
+		if round == maxRounds {
+			return fmt.Errorf("failed to write %v chunks of the program after %v rounds", len(offsets), maxRounds)
+		}
+
+		recentBlockhashRes, err := c.GetLatestBlockhash(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get recent blockhash, err: %v", err)
+		}
+
+		signatures := make([]string, len(offsets))
+		sem := make(chan struct{}, concurrency)
+		var wg sync.WaitGroup
+		for i, offset := range offsets {
+			end := offset + chunkSize
+			if end > len(param.ELF) {
+				end = len(param.ELF)
+			}
+			tx, err := types.NewTransaction(types.NewTransactionParam{
+				Message: types.NewMessage(types.NewMessageParam{
+					FeePayer:        param.Payer.PublicKey,
+					RecentBlockhash: recentBlockhashRes.Blockhash,
+					Instructions: []types.Instruction{
+						bpf_loader_upgradeable.Write(bpf_loader_upgradeable.WriteParam{
+							Buffer:    param.Buffer.PublicKey,
+							Authority: authority.PublicKey,
+							Offset:    uint32(offset),
+							Bytes:     param.ELF[offset:end],
+						}),
+					},
+				}),
+				Signers: []types.Account{param.Payer, authority},
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create new tx, err: %v", err)
+			}
+
+			sem <- struct{}{}
+			wg.Add(1)
+			go func(i int, tx types.Transaction) {
+				defer func() {
+					<-sem
+					wg.Done()
+				}()
+				// a failed send is picked up by the next round
+				signatures[i], _ = c.SendTransaction(ctx, tx)
+			}(i, tx)
+		}
+		wg.Wait()
+
+		sent := make([]string, 0, len(signatures))
+		for _, signature := range signatures {
+			if signature != "" {
+				sent = append(sent, signature)
+			}
+		}
+		if _, err := c.waitForSignatures(ctx, sent, recentBlockhashRes.Blockhash); err != nil {
+			return err
+		}
+	}
+}
+
+// prepareProgramBuffer creates and initializes the buffer if it doesn't exist, otherwise it checks the
+// existing buffer can hold the program. It returns the program part of the buffer.
+func (c *Client) prepareProgramBuffer(ctx context.Context, payer, buffer, authority types.Account, programLen uint64) ([]byte, error) {
+	accountInfo, err := c.GetAccountInfo(ctx, buffer.PublicKey.ToBase58())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get buffer account, err: %v", err)
+	}
+
+	if accountInfo.Owner != (common.PublicKey{}) {
+		state, err := bpf_loader_upgradeable.DeserializeUpgradeableLoaderState(accountInfo.Data, accountInfo.Owner)
+		if err != nil {
+			return nil, fmt.Errorf("failed to deserialize buffer account, err: %w", err)
+		}
+		if state.Type != bpf_loader_upgradeable.UpgradeableLoaderStateBuffer {
+			return nil, fmt.Errorf("%v is not a buffer account", buffer.PublicKey)
+		}
+		if state.Authority == nil || *state.Authority != authority.PublicKey {
+			return nil, fmt.Errorf("buffer authority mismatch, expected: %v, got: %v", authority.PublicKey, state.Authority)
+		}
+		if uint64(len(state.Data)) != programLen {
+			return nil, fmt.Errorf("buffer size mismatch, expected: %v, got: %v", programLen, len(state.Data))
+		}
+		return state.Data, nil
+	}
+
+	bufferSize := bpf_loader_upgradeable.BufferSize(programLen)
+	lamports, err := c.GetMinimumBalanceForRentExemption(ctx, bufferSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get minimum balance for rent exemption, err: %v", err)
+	}
+	_, err = c.sendAndConfirmTransaction(ctx, payer.PublicKey, []types.Account{payer, buffer}, []types.Instruction{
+		system.CreateAccount(system.CreateAccountParam{
+			From:     payer.PublicKey,
+			New:      buffer.PublicKey,
+			Owner:    common.BPFLoaderUpgradeableProgramID,
+			Lamports: lamports,
+			Space:    bufferSize,
+		}),
+		bpf_loader_upgradeable.InitializeBuffer(bpf_loader_upgradeable.InitializeBufferParam{
+			Buffer:    buffer.PublicKey,
+			Authority: authority.PublicKey,
+		}),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create buffer account, err: %w", err)
+	}
+	return make([]byte, programLen), nil
+}
+
+type DeployProgramParam struct {
+	Payer types.Account
+	// Program is the keypair of the new program
+	Program types.Account
+	// Buffer holds the ELF until it is deployed, reuse it to resume a failed deploy
+	Buffer types.Account
+	// Authority is the upgrade authority, default is Payer
+	Authority *types.Account
+	ELF       []byte
+	// MaxDataLen is the max size the program can be upgraded to, default is twice the ELF size
+	MaxDataLen  uint64
+	Concurrency int
+}
+
+// DeployProgram writes an ELF into a buffer and deploys it as a new upgradeable program
+func (c *Client) DeployProgram(ctx context.Context, param DeployProgramParam) (string, error) {
+	authority := param.Payer
+	if param.Authority != nil {
+		authority = *param.Authority
+	}
+	maxDataLen := param.MaxDataLen
+	if maxDataLen == 0 {
+		maxDataLen = 2 * uint64(len(param.ELF))
+	}
+	if maxDataLen < uint64(len(param.ELF)) {
+		return "", fmt.Errorf("max data len %v is less than the program size %v", maxDataLen, len(param.ELF))
+	}
+
+	programInfo, err := c.GetAccountInfo(ctx, param.Program.PublicKey.ToBase58())
+	if err != nil {
+		return "", fmt.Errorf("failed to get program account, err: %v", err)
+	}
+	if programInfo.Owner != (common.PublicKey{}) {
+		return "", fmt.Errorf("program %v already exists", param.Program.PublicKey)
+	}
+
+	err = c.WriteProgramBuffer(ctx, WriteProgramBufferParam{
+		Payer:       param.Payer,
+		Buffer:      param.Buffer,
+		Authority:   &authority,
+		ELF:         param.ELF,
+		Concurrency: param.Concurrency,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	programData, _, err := bpf_loader_upgradeable.GetProgramDataAddress(param.Program.PublicKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to find program data address, err: %v", err)
+	}
+	lamports, err := c.GetMinimumBalanceForRentExemption(ctx, bpf_loader_upgradeable.ProgramSize)
+	if err != nil {
+		return "", fmt.Errorf("failed to get minimum balance for rent exemption, err: %v", err)
+	}
+
+	return c.sendAndConfirmTransaction(ctx, param.Payer.PublicKey, []types.Account{param.Payer, param.Program, authority}, []types.Instruction{
+		system.CreateAccount(system.CreateAccountParam{
+			From:     param.Payer.PublicKey,
+			New:      param.Program.PublicKey,
+			Owner:    common.BPFLoaderUpgradeableProgramID,
+			Lamports: lamports,
+			Space:    bpf_loader_upgradeable.ProgramSize,
+		}),
+		bpf_loader_upgradeable.DeployWithMaxDataLen(bpf_loader_upgradeable.DeployWithMaxDataLenParam{
+			Payer:       param.Payer.PublicKey,
+			ProgramData: programData,
+			Program:     param.Program.PublicKey,
+			Buffer:      param.Buffer.PublicKey,
+			Authority:   authority.PublicKey,
+			MaxDataLen:  maxDataLen,
+		}),
+	})
+}
+
+type UpgradeProgramParam struct {
+	Payer   types.Account
+	Program common.PublicKey
+	// Buffer holds the ELF until it is deployed, reuse it to resume a failed upgrade
+	Buffer types.Account
+	// Authority is the upgrade authority, default is Payer
+	Authority *types.Account
+	ELF       []byte
+	// Spill receives the buffer's lamports, default is Payer
+	Spill       *common.PublicKey
+	Concurrency int
+}
+
+// UpgradeProgram writes an ELF into a buffer and upgrades an existing program with it.
+// The program data account is extended first when the ELF doesn't fit.
+func (c *Client) UpgradeProgram(ctx context.Context, param UpgradeProgramParam) (string, error) {
+	authority := param.Payer
+	if param.Authority != nil {
+		authority = *param.Authority
+	}
+	spill := param.Payer.PublicKey
+	if param.Spill != nil {
+		spill = *param.Spill
+	}
+
+	programData, _, err := bpf_loader_upgradeable.GetProgramDataAddress(param.Program)
+	if err != nil {
+		return "", fmt.Errorf("failed to find program data address, err: %v", err)
+	}
+	programDataState, err := c.GetUpgradeableLoaderState(ctx, programData.ToBase58())
+	if err != nil {
+		return "", fmt.Errorf("failed to get program data account, err: %w", err)
+	}
+	if programDataState.Type != bpf_loader_upgradeable.UpgradeableLoaderStateProgramData {
+		return "", fmt.Errorf("%v is not a program data account", programData)
+	}
+	if programDataState.Authority == nil {
+		return "", fmt.Errorf("program %v is immutable", param.Program)
+	}
+	if *programDataState.Authority != authority.PublicKey {
+		return "", fmt.Errorf("upgrade authority mismatch, expected: %v, got: %v", *programDataState.Authority, authority.PublicKey)
+	}
+
+	err = c.WriteProgramBuffer(ctx, WriteProgramBufferParam{
+		Payer:       param.Payer,
+		Buffer:      param.Buffer,
+		Authority:   &authority,
+		ELF:         param.ELF,
+		Concurrency: param.Concurrency,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	instructions := make([]types.Instruction, 0, 2)
+	if len(param.ELF) > len(programDataState.Data) {
+		instructions = append(instructions, bpf_loader_upgradeable.ExtendProgram(bpf_loader_upgradeable.ExtendProgramParam{
+			ProgramData:     programData,
+			Program:         param.Program,
+			Payer:           &param.Payer.PublicKey,
+			AdditionalBytes: uint32(len(param.ELF) - len(programDataState.Data)),
+		}))
+	}
+	instructions = append(instructions, bpf_loader_upgradeable.Upgrade(bpf_loader_upgradeable.UpgradeParam{
+		ProgramData: programData,
+		Program:     param.Program,
+		Buffer:      param.Buffer.PublicKey,
+		Spill:       spill,
+		Authority:   authority.PublicKey,
+	}))
+
+	return c.sendAndConfirmTransaction(ctx, param.Payer.PublicKey, []types.Account{param.Payer, authority}, instructions)
+}
+
+// programWriteChunkSize is the largest chunk a write transaction can carry
+func programWriteChunkSize(payer, buffer, authority common.PublicKey) (int, error) {
+	message := types.NewMessage(types.NewMessageParam{
+		FeePayer:        payer,
+		RecentBlockhash: payer.ToBase58(),
+		Instructions: []types.Instruction{
+			bpf_loader_upgradeable.Write(bpf_loader_upgradeable.WriteParam{
+				Buffer:    buffer,
+				Authority: authority,
+			}),
+		},
+	})
+	rawMessage, err := message.Serialize()
+	if err != nil {
+		return 0, fmt.Errorf("failed to serialize message, err: %v", err)
+	}
+	// signatures with their compact length, and one more byte as the data length takes two bytes once it is filled
+	size := 1 + 64*int(message.Header.NumRequireSignatures) + len(rawMessage) + 1
+	return packetDataSize - size, nil
+}
+
+// sendAndConfirmTransaction sends a transaction and waits until it is confirmed
+func (c *Client) sendAndConfirmTransaction(ctx context.Context, feePayer common.PublicKey, signers []types.Account, instructions []types.Instruction) (string, error) {
+	recentBlockhashRes, err := c.GetLatestBlockhash(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get recent blockhash, err: %v", err)
+	}
+	tx, err := types.NewTransaction(types.NewTransactionParam{
+		Message: types.NewMessage(types.NewMessageParam{
+			FeePayer:        feePayer,
+			RecentBlockhash: recentBlockhashRes.Blockhash,
+			Instructions:    instructions,
+		}),
+		Signers: signers,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to create new tx, err: %v", err)
+	}
+	signature, err := c.SendTransaction(ctx, tx)
+	if err != nil {
+		return "", err
+	}
+
+	statuses, err := c.waitForSignatures(ctx, []string{signature}, recentBlockhashRes.Blockhash)
+	if err != nil {
+		return signature, err
+	}
+	status, ok := statuses[signature]
+	if !ok {
+		return signature, errors.New("transaction expired before it was confirmed")
+	}
+	if status.Err != nil {
+		return signature, fmt.Errorf("transaction failed, err: %v", status.Err)
+	}
+	return signature, nil
+}
+
+// waitForSignatures polls until every signature is confirmed or the blockhash they were signed with expires.
+// It returns the statuses of the confirmed ones.
+func (c *Client) waitForSignatures(ctx context.Context, signatures []string, blockhash string) (map[string]*rpc.SignatureStatus, error) {
+	confirmed := make(map[string]*rpc.SignatureStatus, len(signatures))
+	pending := signatures
+	expired := false
+	for len(pending) > 0 {
+		next := make([]string, 0, len(pending))
+		for start := 0; start < len(pending); start += maxSignatureStatusesPerRequest {
+			end := start + maxSignatureStatusesPerRequest
+			if end > len(pending) {
+				end = len(pending)
+			}
+			statuses, err := c.GetSignatureStatuses(ctx, pending[start:end])
+			if err != nil {
+				return nil, fmt.Errorf("failed to get signature statuses, err: %v", err)
+			}
+			for i, status := range statuses {
+				if status == nil || status.ConfirmationStatus == nil || *status.ConfirmationStatus == rpc.CommitmentProcessed {
+					next = append(next, pending[start+i])
+					continue
+				}
+				confirmed[pending[start+i]] = status
+			}
+		}
+		if len(next) == 0 || expired {
+			break
+		}
+		pending = next
+
+		valid, err := c.IsBlockhashValid(ctx, blockhash)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check blockhash, err: %v", err)
+		}
+		if !valid {
+			// check once more, they may have landed right before the blockhash expired
+			expired = true
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(transactionConfirmPollInterval):
+		}
+	}
+	return confirmed, nil
+}
@@ -0,0 +1,274 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	"github.com/qimeila/solana-go-sdk/common"
+	"github.com/qimeila/solana-go-sdk/internal/client_test"
+	"github.com/qimeila/solana-go-sdk/program/bpf_loader_upgradeable"
+	"github.com/qimeila/solana-go-sdk/program/system"
+	"github.com/qimeila/solana-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+const testBlockhash = "DjQ4csyDJ9ZQvNNbK838ATs5UrqMq8s4Pd5i1ts22HAQ"
+
+func testAccountFromSeed(t *testing.T, b byte) types.Account {
+	account, err := types.AccountFromSeed(bytes.Repeat([]byte{b}, 32))
+	assert.Nil(t, err)
+	return account
+}
+
+func sendTransactionMock(t *testing.T, feePayer common.PublicKey, signers []types.Account, instructions []types.Instruction, signature string) []client_test.Mock {
+	tx, err := types.NewTransaction(types.NewTransactionParam{
+		Message: types.NewMessage(types.NewMessageParam{
+			FeePayer:        feePayer,
+			RecentBlockhash: testBlockhash,
+			Instructions:    instructions,
+		}),
+		Signers: signers,
+	})
+	assert.Nil(t, err)
+	rawTx, err := tx.Serialize()
+	assert.Nil(t, err)
+
+	return []client_test.Mock{
+		{
+			RequestBody:  fmt.Sprintf(`{"jsonrpc":"2.0", "id":1, "method":"sendTransaction", "params":["%v", {"encoding":"base64"}]}`, base64.StdEncoding.EncodeToString(rawTx)),
+			ResponseBody: fmt.Sprintf(`{"jsonrpc":"2.0","result":"%v","id":1}`, signature),
+		},
+		{
+			RequestBody:  fmt.Sprintf(`{"jsonrpc":"2.0", "id":1, "method":"getSignatureStatuses", "params":[["%v"]]}`, signature),
+			ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"apiVersion":"1.14.10","slot":187545900},"value":[{"confirmationStatus":"confirmed","confirmations":1,"err":null,"slot":187545890,"status":{"Ok":null}}]},"id":1}`,
+		},
+	}
+}
+
+func loaderAccountResponse(data []byte) string {
+	return fmt.Sprintf(`{"jsonrpc":"2.0","result":{"context":{"apiVersion":"1.14.10","slot":187545846},"value":{"data":["%v","base64"],"executable":false,"lamports":1000000000,"owner":"BPFLoaderUpgradeab1e11111111111111111111111","rentEpoch":0}},"id":1}`, base64.StdEncoding.EncodeToString(data))
+}
+
+func bufferData(authority common.PublicKey, program []byte) []byte {
+	data := make([]byte, bpf_loader_upgradeable.BufferSize(uint64(len(program))))
+	binary.LittleEndian.PutUint32(data, uint32(bpf_loader_upgradeable.UpgradeableLoaderStateBuffer))
+	data[4] = 1
+	copy(data[5:], authority.Bytes())
+	copy(data[bpf_loader_upgradeable.BufferMetadataSize:], program)
+	return data
+}
+
+func TestClient_DeployProgram(t *testing.T) {
+	payer := testAccountFromSeed(t, 1)
+	program := testAccountFromSeed(t, 2)
+	buffer := testAccountFromSeed(t, 3)
+	elf := []byte{0x7f, 0x45, 0x4c, 0x46}
+	programData, _, err := bpf_loader_upgradeable.GetProgramDataAddress(program.PublicKey)
+	assert.Nil(t, err)
+
+	getAccountInfoRequest := `{"jsonrpc":"2.0", "id":1, "method":"getAccountInfo", "params":["%v", {"encoding": "base64"}]}`
+	nullResponse := `{"jsonrpc":"2.0","result":{"context":{"apiVersion":"1.14.10","slot":187545846},"value":null},"id":1}`
+
+	mocks := []client_test.Mock{
+		{
+			RequestBody:  fmt.Sprintf(getAccountInfoRequest, program.PublicKey),
+			ResponseBody: nullResponse,
+		},
+		{
+			RequestBody:  fmt.Sprintf(getAccountInfoRequest, buffer.PublicKey),
+			ResponseBody: nullResponse,
+		},
+		{
+			RequestBody:  fmt.Sprintf(getAccountInfoRequest, buffer.PublicKey),
+			ResponseBody: loaderAccountResponse(bufferData(payer.PublicKey, elf)),
+		},
+		{
+			RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getLatestBlockhash"}`,
+			ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"apiVersion":"1.14.10","slot":187545846},"value":{"blockhash":"DjQ4csyDJ9ZQvNNbK838ATs5UrqMq8s4Pd5i1ts22HAQ","lastValidBlockHeight":177067026}},"id":1}`,
+		},
+		{
+			RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getMinimumBalanceForRentExemption", "params":[41]}`,
+			ResponseBody: `{"jsonrpc":"2.0","result":1176240,"id":1}`,
+		},
+		{
+			RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getMinimumBalanceForRentExemption", "params":[36]}`,
+			ResponseBody: `{"jsonrpc":"2.0","result":1141440,"id":1}`,
+		},
+	}
+	mocks = append(mocks, sendTransactionMock(t, payer.PublicKey, []types.Account{payer, buffer}, []types.Instruction{
+		system.CreateAccount(system.CreateAccountParam{
+			From:     payer.PublicKey,
+			New:      buffer.PublicKey,
+			Owner:    common.BPFLoaderUpgradeableProgramID,
+			Lamports: 1176240,
+			Space:    41,
+		}),
+		bpf_loader_upgradeable.InitializeBuffer(bpf_loader_upgradeable.InitializeBufferParam{
+			Buffer:    buffer.PublicKey,
+			Authority: payer.PublicKey,
+		}),
+	}, "CreateBufferSignature")...)
+	mocks = append(mocks, sendTransactionMock(t, payer.PublicKey, []types.Account{payer}, []types.Instruction{
+		bpf_loader_upgradeable.Write(bpf_loader_upgradeable.WriteParam{
+			Buffer:    buffer.PublicKey,
+			Authority: payer.PublicKey,
+			Offset:    0,
+			Bytes:     elf,
+		}),
+	}, "WriteSignature")...)
+	mocks = append(mocks, sendTransactionMock(t, payer.PublicKey, []types.Account{payer, program}, []types.Instruction{
+		system.CreateAccount(system.CreateAccountParam{
+			From:     payer.PublicKey,
+			New:      program.PublicKey,
+			Owner:    common.BPFLoaderUpgradeableProgramID,
+			Lamports: 1141440,
+			Space:    36,
+		}),
+		bpf_loader_upgradeable.DeployWithMaxDataLen(bpf_loader_upgradeable.DeployWithMaxDataLenParam{
+			Payer:       payer.PublicKey,
+			ProgramData: programData,
+			Program:     program.PublicKey,
+			Buffer:      buffer.PublicKey,
+			Authority:   payer.PublicKey,
+			MaxDataLen:  8,
+		}),
+	}, "DeploySignature")...)
+
+	server := client_test.NewMockServer(t, mocks)
+	defer server.Close()
+
+	c := NewClient(server.URL)
+	got, err := c.DeployProgram(context.Background(), DeployProgramParam{
+		Payer:   payer,
+		Program: program,
+		Buffer:  buffer,
+		ELF:     elf,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "DeploySignature", got)
+}
+
+func TestClient_UpgradeProgram(t *testing.T) {
+	payer := testAccountFromSeed(t, 1)
+	authority := testAccountFromSeed(t, 4)
+	buffer := testAccountFromSeed(t, 3)
+	program := common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm")
+	elf := []byte{0x7f, 0x45, 0x4c, 0x46, 0x02, 0x01}
+	programData, _, err := bpf_loader_upgradeable.GetProgramDataAddress(program)
+	assert.Nil(t, err)
+
+	programDataData := make([]byte, bpf_loader_upgradeable.ProgramDataSize(4))
+	binary.LittleEndian.PutUint32(programDataData, uint32(bpf_loader_upgradeable.UpgradeableLoaderStateProgramData))
+	binary.LittleEndian.PutUint64(programDataData[4:], 100)
+	programDataData[12] = 1
+	copy(programDataData[13:], authority.PublicKey.Bytes())
+
+	getAccountInfoRequest := `{"jsonrpc":"2.0", "id":1, "method":"getAccountInfo", "params":["%v", {"encoding": "base64"}]}`
+	mocks := []client_test.Mock{
+		{
+			RequestBody:  fmt.Sprintf(getAccountInfoRequest, programData),
+			ResponseBody: loaderAccountResponse(programDataData),
+		},
+		{
+			// an earlier attempt has written the buffer already
+			RequestBody:  fmt.Sprintf(getAccountInfoRequest, buffer.PublicKey),
+			ResponseBody: loaderAccountResponse(bufferData(authority.PublicKey, elf)),
+		},
+		{
+			RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getLatestBlockhash"}`,
+			ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"apiVersion":"1.14.10","slot":187545846},"value":{"blockhash":"DjQ4csyDJ9ZQvNNbK838ATs5UrqMq8s4Pd5i1ts22HAQ","lastValidBlockHeight":177067026}},"id":1}`,
+		},
+	}
+	mocks = append(mocks, sendTransactionMock(t, payer.PublicKey, []types.Account{payer, authority}, []types.Instruction{
+		bpf_loader_upgradeable.ExtendProgram(bpf_loader_upgradeable.ExtendProgramParam{
+			ProgramData:     programData,
+			Program:         program,
+			Payer:           &payer.PublicKey,
+			AdditionalBytes: 2,
+		}),
+		bpf_loader_upgradeable.Upgrade(bpf_loader_upgradeable.UpgradeParam{
+			ProgramData: programData,
+			Program:     program,
+			Buffer:      buffer.PublicKey,
+			Spill:       payer.PublicKey,
+			Authority:   authority.PublicKey,
+		}),
+	}, "UpgradeSignature")...)
+
+	server := client_test.NewMockServer(t, mocks)
+	defer server.Close()
+
+	c := NewClient(server.URL)
+	got, err := c.UpgradeProgram(context.Background(), UpgradeProgramParam{
+		Payer:     payer,
+		Program:   program,
+		Buffer:    buffer,
+		Authority: &authority,
+		ELF:       elf,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "UpgradeSignature", got)
+}
+
+func TestClient_UpgradeProgram_AuthorityMismatch(t *testing.T) {
+	payer := testAccountFromSeed(t, 1)
+	buffer := testAccountFromSeed(t, 3)
+	program := common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm")
+	programData, _, err := bpf_loader_upgradeable.GetProgramDataAddress(program)
+	assert.Nil(t, err)
+
+	programDataData := make([]byte, bpf_loader_upgradeable.ProgramDataSize(4))
+	binary.LittleEndian.PutUint32(programDataData, uint32(bpf_loader_upgradeable.UpgradeableLoaderStateProgramData))
+	programDataData[12] = 1
+	copy(programDataData[13:], testAccountFromSeed(t, 4).PublicKey.Bytes())
+
+	server := client_test.NewMockServer(t, []client_test.Mock{
+		{
+			RequestBody:  fmt.Sprintf(`{"jsonrpc":"2.0", "id":1, "method":"getAccountInfo", "params":["%v", {"encoding": "base64"}]}`, programData),
+			ResponseBody: loaderAccountResponse(programDataData),
+		},
+	})
+	defer server.Close()
+
+	c := NewClient(server.URL)
+	_, err = c.UpgradeProgram(context.Background(), UpgradeProgramParam{
+		Payer:   payer,
+		Program: program,
+		Buffer:  buffer,
+		ELF:     []byte{1},
+	})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "upgrade authority mismatch")
+}
+
+func TestProgramWriteChunkSize(t *testing.T) {
+	payer := testAccountFromSeed(t, 1)
+	buffer := testAccountFromSeed(t, 3)
+	for _, authority := range []types.Account{payer, testAccountFromSeed(t, 4)} {
+		chunkSize, err := programWriteChunkSize(payer.PublicKey, buffer.PublicKey, authority.PublicKey)
+		assert.Nil(t, err)
+
+		tx, err := types.NewTransaction(types.NewTransactionParam{
+			Message: types.NewMessage(types.NewMessageParam{
+				FeePayer:        payer.PublicKey,
+				RecentBlockhash: testBlockhash,
+				Instructions: []types.Instruction{
+					bpf_loader_upgradeable.Write(bpf_loader_upgradeable.WriteParam{
+						Buffer:    buffer.PublicKey,
+						Authority: authority.PublicKey,
+						Bytes:     make([]byte, chunkSize),
+					}),
+				},
+			}),
+			Signers: []types.Account{payer, authority},
+		})
+		assert.Nil(t, err)
+		rawTx, err := tx.Serialize()
+		assert.Nil(t, err)
+		assert.Equal(t, packetDataSize, len(rawTx))
+	}
+}
@@ -0,0 +1,134 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/qimeila/solana-go-sdk/common"
+	"github.com/qimeila/solana-go-sdk/program/name_service"
+)
+
+// GetNameRecord fetches a name record, the payload after the header is in Data
+func (c *Client) GetNameRecord(ctx context.Context, base58Addr string) (name_service.NameRecordHeader, error) {
+	accountInfo, err := c.GetAccountInfo(ctx, base58Addr)
+	if err != nil {
+		return name_service.NameRecordHeader{}, err
+	}
+	return name_service.DeserializeNameRecord(accountInfo.Data, accountInfo.Owner)
+}
+
+// Resolve returns the wallet a .sol domain points to, e.g. "alice.sol" or "pay.alice.sol".
+// A valid SOL record v2 is preferred, then a valid SOL record v1, then the domain owner.
+func (c *Client) Resolve(ctx context.Context, domain string) (common.PublicKey, error) {
+	domainKey, err := name_service.GetDomainKey(domain)
+	if err != nil {
+		return common.PublicKey{}, err
+	}
+	recordV2Key := name_service.GetRecordV2Key(domainKey, name_service.RecordSOL)
+	recordKey := name_service.GetRecordKey(domainKey, name_service.RecordSOL)
+
+	accountInfos, err := c.GetMultipleAccounts(ctx, []string{domainKey.ToBase58(), recordV2Key.ToBase58(), recordKey.ToBase58()})
+	if err != nil {
+		return common.PublicKey{}, err
+	}
+	if len(accountInfos) != 3 {
+		return common.PublicKey{}, fmt.Errorf("unexpected number of accounts, got: %v", len(accountInfos))
+	}
+
+	if accountInfos[0].Owner == (common.PublicKey{}) {
+		return common.PublicKey{}, fmt.Errorf("%w: %v", name_service.ErrDomainNotFound, domain)
+	}
+	header, err := name_service.DeserializeNameRecord(accountInfos[0].Data, accountInfos[0].Owner)
+	if err != nil {
+		return common.PublicKey{}, fmt.Errorf("failed to deserialize domain, err: %w", err)
+	}
+
+	if recordV2, err := name_service.DeserializeNameRecord(accountInfos[1].Data, accountInfos[1].Owner); err == nil {
+		if record, err := name_service.DeserializeRecordV2(recordV2.Data); err == nil {
+			if destination, ok := record.SolDestination(header.Owner); ok {
+				return destination, nil
+			}
+		}
+	}
+	if record, err := name_service.DeserializeNameRecord(accountInfos[2].Data, accountInfos[2].Owner); err == nil {
+		if destination, ok := name_service.SolRecordV1Destination(record.Data, recordKey, header.Owner); ok {
+			return destination, nil
+		}
+	}
+	return header.Owner, nil
+}
+
+// ReverseLookup returns the .sol domain of a name account, or the favourite domain of a wallet
+func (c *Client) ReverseLookup(ctx context.Context, base58Addr string) (string, error) {
+	accountInfo, err := c.GetAccountInfo(ctx, base58Addr)
+	if err != nil {
+		return "", err
+	}
+
+	nameAccount := common.PublicKeyFromString(base58Addr)
+	if accountInfo.Owner != common.SPLNameServiceProgramID {
+		favouriteDomainInfo, err := c.GetAccountInfo(ctx, name_service.GetFavouriteDomainKey(nameAccount).ToBase58())
+		if err != nil {
+			return "", err
+		}
+		if favouriteDomainInfo.Owner == (common.PublicKey{}) {
+			return "", fmt.Errorf("%w: %v", name_service.ErrDomainNotFound, base58Addr)
+		}
+		favouriteDomain, err := name_service.DeserializeFavouriteDomain(favouriteDomainInfo.Data, favouriteDomainInfo.Owner)
+		if err != nil {
+			return "", fmt.Errorf("failed to deserialize favourite domain, err: %w", err)
+		}
+		wallet := nameAccount
+		nameAccount = favouriteDomain.NameAccount
+		accountInfo, err = c.GetAccountInfo(ctx, nameAccount.ToBase58())
+		if err != nil {
+			return "", err
+		}
+		header, err := name_service.DeserializeNameRecord(accountInfo.Data, accountInfo.Owner)
+		if err != nil {
+			return "", fmt.Errorf("failed to deserialize domain, err: %w", err)
+		}
+		// the favourite domain is stale once the domain is transferred
+		if header.Owner != wallet {
+			return "", fmt.Errorf("%w: %v", name_service.ErrDomainNotFound, base58Addr)
+		}
+	}
+
+	header, err := name_service.DeserializeNameRecord(accountInfo.Data, accountInfo.Owner)
+	if err != nil {
+		return "", fmt.Errorf("failed to deserialize domain, err: %w", err)
+	}
+
+	if header.ParentName == name_service.SolTldAuthority {
+		name, err := c.getReverseName(ctx, name_service.GetReverseKey(nameAccount, common.PublicKey{}))
+		if err != nil {
+			return "", err
+		}
+		return name + name_service.SolTld, nil
+	}
+
+	sub, err := c.getReverseName(ctx, name_service.GetReverseKey(nameAccount, header.ParentName))
+	if err != nil {
+		return "", err
+	}
+	parent, err := c.getReverseName(ctx, name_service.GetReverseKey(header.ParentName, common.PublicKey{}))
+	if err != nil {
+		return "", err
+	}
+	return sub + "." + parent + name_service.SolTld, nil
+}
+
+func (c *Client) getReverseName(ctx context.Context, reverseKey common.PublicKey) (string, error) {
+	accountInfo, err := c.GetAccountInfo(ctx, reverseKey.ToBase58())
+	if err != nil {
+		return "", err
+	}
+	if accountInfo.Owner == (common.PublicKey{}) {
+		return "", fmt.Errorf("%w: reverse record %v", name_service.ErrDomainNotFound, reverseKey.ToBase58())
+	}
+	header, err := name_service.DeserializeNameRecord(accountInfo.Data, accountInfo.Owner)
+	if err != nil {
+		return "", fmt.Errorf("failed to deserialize reverse record, err: %w", err)
+	}
+	return name_service.DeserializeReverseName(header.Data)
+}
@@ -0,0 +1,177 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/qimeila/solana-go-sdk/common"
+	"github.com/qimeila/solana-go-sdk/internal/client_test"
+	"github.com/qimeila/solana-go-sdk/program/name_service"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_GetNameRecord(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getAccountInfo", "params":["CsAJLjVMY5v3FoUmbf8DSWUGrrNsHfu9dtYCt2t46ZiP", {"encoding": "base64"}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"apiVersion":"1.14.10","slot":187552526},"value":{"data":["AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABAgMEBQYHCAkKCwwNDg8QERITFBUWFxgZGhscHR4fIAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAaGVsbG8=","base64"],"executable":false,"lamports":1559040,"owner":"namesLPneVptA9Z5rqUDD9tMTWEJwofgaYwp8cawRkX","rentEpoch":0}},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.GetNameRecord(context.TODO(), "CsAJLjVMY5v3FoUmbf8DSWUGrrNsHfu9dtYCt2t46ZiP")
+				},
+				ExpectedValue: name_service.NameRecordHeader{
+					ParentName: common.PublicKey{},
+					Owner:      common.PublicKeyFromBytes([]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32}),
+					Class:      common.PublicKey{},
+					Data:       []byte("hello"),
+				},
+				ExpectedError: nil,
+			},
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getAccountInfo", "params":["CsAJLjVMY5v3FoUmbf8DSWUGrrNsHfu9dtYCt2t46ZiP", {"encoding": "base64"}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"apiVersion":"1.14.10","slot":187552526},"value":{"data":["AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABAgMEBQYHCAkKCwwNDg8QERITFBUWFxgZGhscHR4fIAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAaGVsbG8=","base64"],"executable":false,"lamports":1559040,"owner":"11111111111111111111111111111111","rentEpoch":0}},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.GetNameRecord(context.TODO(), "CsAJLjVMY5v3FoUmbf8DSWUGrrNsHfu9dtYCt2t46ZiP")
+				},
+				ExpectedValue: name_service.NameRecordHeader{},
+				ExpectedError: name_service.ErrInvalidAccountOwner,
+			},
+		},
+	)
+}
+
+func TestClient_Resolve(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getMultipleAccounts", "params":[["Crf8hzfthWGbGbLTVCiqRqV5MVnbpHB1L9KQMd6gsinb", "ETARvCjLwjyM6Jux1ndxuXuYEYy56Nf5uvU3abL1WyW6", "5WCZ6uhXPXJ7UrzBvXBnE9biZykq1ezJ6JhYe6CHgA7d"], {"encoding": "base64"}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"apiVersion":"1.14.10","slot":187552526},"value":[{"data":["PVPCSzg2DtOBOiPfst/YIKtYIct5KaONLqqyUug4JZXybLcicCAgnC2mdJSPjzwzDuT5o4Yla9FPN6bgxWdUKwAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA","base64"],"executable":false,"lamports":1559040,"owner":"namesLPneVptA9Z5rqUDD9tMTWEJwofgaYwp8cawRkX","rentEpoch":0},null,null]},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.Resolve(context.TODO(), "bonfida.sol")
+				},
+				ExpectedValue: common.PublicKeyFromString("HKKp49qGWXd639QsuH7JiLijfVW5UtCVY4s1n2HANwEA"),
+				ExpectedError: nil,
+			},
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getMultipleAccounts", "params":[["Crf8hzfthWGbGbLTVCiqRqV5MVnbpHB1L9KQMd6gsinb", "ETARvCjLwjyM6Jux1ndxuXuYEYy56Nf5uvU3abL1WyW6", "5WCZ6uhXPXJ7UrzBvXBnE9biZykq1ezJ6JhYe6CHgA7d"], {"encoding": "base64"}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"apiVersion":"1.14.10","slot":187552526},"value":[{"data":["PVPCSzg2DtOBOiPfst/YIKtYIct5KaONLqqyUug4JZXybLcicCAgnC2mdJSPjzwzDuT5o4Yla9FPN6bgxWdUKwAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA","base64"],"executable":false,"lamports":1559040,"owner":"namesLPneVptA9Z5rqUDD9tMTWEJwofgaYwp8cawRkX","rentEpoch":0},{"data":["sCkiACVJ1w7BDbeEGKFJSZ13UQ1cykEt/ivyFoy0AHjybLcicCAgnC2mdJSPjzwzDuT5o4Yla9FPN6bgxWdUKxr9fhY8dfvrqc6igckULAzFLoz45MnVZuy6ln8SE/KqAQABACAAAADybLcicCAgnC2mdJSPjzwzDuT5o4Yla9FPN6bgxWdUK1h/aj2rZec+Et5nvDFzLaBO6vsSg90hEIJcyx7feaKwWH9qPatl5z4S3me8MXMtoE7q+xKD3SEQglzLHt95orA=","base64"],"executable":false,"lamports":1559040,"owner":"namesLPneVptA9Z5rqUDD9tMTWEJwofgaYwp8cawRkX","rentEpoch":0},null]},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.Resolve(context.TODO(), "bonfida.sol")
+				},
+				ExpectedValue: common.PublicKeyFromString("6xTZhtNA8aaipc2hHFP616gFvDcvWmYMGsDFHwrsF3m1"),
+				ExpectedError: nil,
+			},
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getMultipleAccounts", "params":[["Crf8hzfthWGbGbLTVCiqRqV5MVnbpHB1L9KQMd6gsinb", "ETARvCjLwjyM6Jux1ndxuXuYEYy56Nf5uvU3abL1WyW6", "5WCZ6uhXPXJ7UrzBvXBnE9biZykq1ezJ6JhYe6CHgA7d"], {"encoding": "base64"}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"apiVersion":"1.14.10","slot":187552526},"value":[null,null,null]},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.Resolve(context.TODO(), "bonfida.sol")
+				},
+				ExpectedValue: common.PublicKey{},
+				ExpectedError: fmt.Errorf("%w: bonfida.sol", name_service.ErrDomainNotFound),
+			},
+		},
+	)
+}
+
+func TestClient_ReverseLookup(t *testing.T) {
+	tests := []struct {
+		name       string
+		mocks      []client_test.Mock
+		base58Addr string
+		want       string
+		err        error
+	}{
+		{
+			name: "domain",
+			mocks: []client_test.Mock{
+				{
+					RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getAccountInfo", "params":["Crf8hzfthWGbGbLTVCiqRqV5MVnbpHB1L9KQMd6gsinb", {"encoding": "base64"}]}`,
+					ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"apiVersion":"1.14.10","slot":187552526},"value":{"data":["PVPCSzg2DtOBOiPfst/YIKtYIct5KaONLqqyUug4JZXybLcicCAgnC2mdJSPjzwzDuT5o4Yla9FPN6bgxWdUKwAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA","base64"],"executable":false,"lamports":1559040,"owner":"namesLPneVptA9Z5rqUDD9tMTWEJwofgaYwp8cawRkX","rentEpoch":0}},"id":1}`,
+				},
+				{
+					RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getAccountInfo", "params":["DqgmWxe2PPrfy45Ja3UPyFGwcbRzkRuwXt3NyxjX8krg", {"encoding": "base64"}]}`,
+					ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"apiVersion":"1.14.10","slot":187552526},"value":{"data":["AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA9U8JLODYO04E6I9+y39ggq1ghy3kpo40uqrJS6DgllR5sWOSxtUooW88UPuaniDVu+obiWfPYqxZq9A72K0mOBwAAAGJvbmZpZGE=","base64"],"executable":false,"lamports":1559040,"owner":"namesLPneVptA9Z5rqUDD9tMTWEJwofgaYwp8cawRkX","rentEpoch":0}},"id":1}`,
+				},
+			},
+			base58Addr: "Crf8hzfthWGbGbLTVCiqRqV5MVnbpHB1L9KQMd6gsinb",
+			want:       "bonfida.sol",
+		},
+		{
+			name: "subdomain",
+			mocks: []client_test.Mock{
+				{
+					RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getAccountInfo", "params":["HoFfFXqFHAC8RP3duuQNzag1ieUwJRBv1HtRNiWFq4Qu", {"encoding": "base64"}]}`,
+					ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"apiVersion":"1.14.10","slot":187552526},"value":{"data":["sCkiACVJ1w7BDbeEGKFJSZ13UQ1cykEt/ivyFoy0AHjybLcicCAgnC2mdJSPjzwzDuT5o4Yla9FPN6bgxWdUKwAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA","base64"],"executable":false,"lamports":1559040,"owner":"namesLPneVptA9Z5rqUDD9tMTWEJwofgaYwp8cawRkX","rentEpoch":0}},"id":1}`,
+				},
+				{
+					RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getAccountInfo", "params":["6tAdEpjsrzHuRqJW3XMXEV7DFyCWW4giW6mW4bgvhcYV", {"encoding": "base64"}]}`,
+					ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"apiVersion":"1.14.10","slot":187552526},"value":{"data":["sCkiACVJ1w7BDbeEGKFJSZ13UQ1cykEt/ivyFoy0AHjybLcicCAgnC2mdJSPjzwzDuT5o4Yla9FPN6bgxWdUKx5sWOSxtUooW88UPuaniDVu+obiWfPYqxZq9A72K0mOBAAAAABkZXg=","base64"],"executable":false,"lamports":1559040,"owner":"namesLPneVptA9Z5rqUDD9tMTWEJwofgaYwp8cawRkX","rentEpoch":0}},"id":1}`,
+				},
+				{
+					RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getAccountInfo", "params":["DqgmWxe2PPrfy45Ja3UPyFGwcbRzkRuwXt3NyxjX8krg", {"encoding": "base64"}]}`,
+					ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"apiVersion":"1.14.10","slot":187552526},"value":{"data":["AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA9U8JLODYO04E6I9+y39ggq1ghy3kpo40uqrJS6DgllR5sWOSxtUooW88UPuaniDVu+obiWfPYqxZq9A72K0mOBwAAAGJvbmZpZGE=","base64"],"executable":false,"lamports":1559040,"owner":"namesLPneVptA9Z5rqUDD9tMTWEJwofgaYwp8cawRkX","rentEpoch":0}},"id":1}`,
+				},
+			},
+			base58Addr: "HoFfFXqFHAC8RP3duuQNzag1ieUwJRBv1HtRNiWFq4Qu",
+			want:       "dex.bonfida.sol",
+		},
+		{
+			name: "wallet",
+			mocks: []client_test.Mock{
+				{
+					RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getAccountInfo", "params":["HKKp49qGWXd639QsuH7JiLijfVW5UtCVY4s1n2HANwEA", {"encoding": "base64"}]}`,
+					ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"apiVersion":"1.14.10","slot":187552526},"value":{"data":["","base64"],"executable":false,"lamports":1000000,"owner":"11111111111111111111111111111111","rentEpoch":0}},"id":1}`,
+				},
+				{
+					RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getAccountInfo", "params":["CvPVErneDniggxaCNhkTB1C8BAmVZ5Te7bKWyyWxpuz", {"encoding": "base64"}]}`,
+					ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"apiVersion":"1.14.10","slot":187552526},"value":{"data":["AbApIgAlSdcOwQ23hBihSUmdd1ENXMpBLf4r8haMtAB4","base64"],"executable":false,"lamports":1559040,"owner":"85iDfUvr3HJyLM2zcq5BXSiDvUWfw6cSE1FfNBo8Ap29","rentEpoch":0}},"id":1}`,
+				},
+				{
+					RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getAccountInfo", "params":["Crf8hzfthWGbGbLTVCiqRqV5MVnbpHB1L9KQMd6gsinb", {"encoding": "base64"}]}`,
+					ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"apiVersion":"1.14.10","slot":187552526},"value":{"data":["PVPCSzg2DtOBOiPfst/YIKtYIct5KaONLqqyUug4JZXybLcicCAgnC2mdJSPjzwzDuT5o4Yla9FPN6bgxWdUKwAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA","base64"],"executable":false,"lamports":1559040,"owner":"namesLPneVptA9Z5rqUDD9tMTWEJwofgaYwp8cawRkX","rentEpoch":0}},"id":1}`,
+				},
+				{
+					RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getAccountInfo", "params":["DqgmWxe2PPrfy45Ja3UPyFGwcbRzkRuwXt3NyxjX8krg", {"encoding": "base64"}]}`,
+					ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"apiVersion":"1.14.10","slot":187552526},"value":{"data":["AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA9U8JLODYO04E6I9+y39ggq1ghy3kpo40uqrJS6DgllR5sWOSxtUooW88UPuaniDVu+obiWfPYqxZq9A72K0mOBwAAAGJvbmZpZGE=","base64"],"executable":false,"lamports":1559040,"owner":"namesLPneVptA9Z5rqUDD9tMTWEJwofgaYwp8cawRkX","rentEpoch":0}},"id":1}`,
+				},
+			},
+			base58Addr: "HKKp49qGWXd639QsuH7JiLijfVW5UtCVY4s1n2HANwEA",
+			want:       "bonfida.sol",
+		},
+		{
+			name: "wallet without favourite domain",
+			mocks: []client_test.Mock{
+				{
+					RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getAccountInfo", "params":["HKKp49qGWXd639QsuH7JiLijfVW5UtCVY4s1n2HANwEA", {"encoding": "base64"}]}`,
+					ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"apiVersion":"1.14.10","slot":187552526},"value":{"data":["","base64"],"executable":false,"lamports":1000000,"owner":"11111111111111111111111111111111","rentEpoch":0}},"id":1}`,
+				},
+				{
+					RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getAccountInfo", "params":["CvPVErneDniggxaCNhkTB1C8BAmVZ5Te7bKWyyWxpuz", {"encoding": "base64"}]}`,
+					ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"apiVersion":"1.14.10","slot":187552526},"value":null},"id":1}`,
+				},
+			},
+			base58Addr: "HKKp49qGWXd639QsuH7JiLijfVW5UtCVY4s1n2HANwEA",
+			err:        name_service.ErrDomainNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := client_test.NewMockServer(t, tt.mocks)
+			defer server.Close()
+
+			c := NewClient(server.URL)
+			got, err := c.ReverseLookup(context.Background(), tt.base58Addr)
+			assert.ErrorIs(t, err, tt.err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"strconv"
 
 	"github.com/qimeila/solana-go-sdk/common"
+	"github.com/qimeila/solana-go-sdk/program/token"
 	"github.com/qimeila/solana-go-sdk/rpc"
 )
 
@@ -35,6 +36,11 @@ type TokenAmount struct {
 	UIAmountString string
 }
 
+// ToTokenAmount converts it to token.TokenAmount for exact ui formatting and checked math
+func (a TokenAmount) ToTokenAmount() token.TokenAmount {
+	return token.NewTokenAmount(a.Amount, a.Decimals)
+}
+
 func newTokenAmount(amount string, decimals uint8, uiAmountString string) (TokenAmount, error) {
 	u64Amount, err := strconv.ParseUint(amount, 10, 64)
 	if err != nil {
@@ -0,0 +1,133 @@
+package client
+
+import (
+	"context"
+
+	"github.com/qimeila/solana-go-sdk/rpc"
+)
+
+type DasPagination = rpc.DasPagination
+type DasSorting = rpc.DasSorting
+type DasDisplayOptions = rpc.DasDisplayOptions
+
+// DasPage is a page of a das list method
+type DasPage[T any] struct {
+	Total      int
+	Limit      int
+	Page       int
+	Cursor     string
+	Before     string
+	After      string
+	GrandTotal *int
+	Items      []T
+}
+
+// DasIterator walks all pages of a das list method.
+// It follows the returned cursor unless a page number is given, then it asks for the next page.
+type DasIterator[T any] struct {
+	fetch      func(ctx context.Context, pagination DasPagination) (DasPage[T], error)
+	pagination DasPagination
+	page       DasPage[T]
+	err        error
+	done       bool
+}
+
+func newDasIterator[T any](pagination DasPagination, fetch func(context.Context, DasPagination) (DasPage[T], error)) *DasIterator[T] {
+	return &DasIterator[T]{
+		fetch:      fetch,
+		pagination: pagination,
+	}
+}
+
+// Next fetches the next page, it returns false when there are no more pages or an error occurred
+func (it *DasIterator[T]) Next(ctx context.Context) bool {
+	if it.done || it.err != nil {
+		return false
+	}
+
+	page, err := it.fetch(ctx, it.pagination)
+	if err != nil {
+		it.err = err
+		return false
+	}
+	if len(page.Items) == 0 {
+		it.done = true
+		return false
+	}
+	it.page = page
+
+	if it.pagination.Page == 0 && page.Cursor != "" {
+		if page.Cursor == it.pagination.Cursor {
+			it.done = true
+		}
+		it.pagination.Cursor = page.Cursor
+	} else {
+		current := it.pagination.Page
+		if current == 0 {
+			current = page.Page
+		}
+		if current == 0 {
+			current = 1
+		}
+		it.pagination.Page = current + 1
+	}
+
+	limit := it.pagination.Limit
+	if limit == 0 {
+		limit = page.Limit
+	}
+	if limit > 0 && len(page.Items) < limit {
+		it.done = true
+	}
+	return true
+}
+
+// Page returns the page fetched by the last Next
+func (it *DasIterator[T]) Page() DasPage[T] {
+	return it.page
+}
+
+// Err returns the error which stopped the iterator
+func (it *DasIterator[T]) Err() error {
+	return it.err
+}
+
+// All collects the items of the remaining pages
+func (it *DasIterator[T]) All(ctx context.Context) ([]T, error) {
+	items := []T{}
+	for it.Next(ctx) {
+		items = append(items, it.page.Items...)
+	}
+	return items, it.err
+}
+
+func convertDasPage[A any, B any](v rpc.DasPage[A], convert func(A) (B, error)) (DasPage[B], error) {
+	items := make([]B, 0, len(v.Items))
+	for _, item := range v.Items {
+		b, err := convert(item)
+		if err != nil {
+			return DasPage[B]{}, err
+		}
+		items = append(items, b)
+	}
+	return DasPage[B]{
+		Total:      v.Total,
+		Limit:      v.Limit,
+		Page:       v.Page,
+		Cursor:     v.Cursor,
+		Before:     v.Before,
+		After:      v.After,
+		GrandTotal: v.GrandTotal,
+		Items:      items,
+	}, nil
+}
+
+func convertAssetList(v rpc.AssetList) (DasPage[Asset], error) {
+	return convertDasPage(v, func(a rpc.Asset) (Asset, error) {
+		asset, err := convertAsset(a)
+		if err != nil || asset == nil {
+			return Asset{}, err
+		}
+		return *asset, nil
+	})
+}
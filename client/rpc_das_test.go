@@ -0,0 +1,210 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/qimeila/solana-go-sdk/common"
+	"github.com/qimeila/solana-go-sdk/internal/client_test"
+	"github.com/qimeila/solana-go-sdk/rpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_GetAsset(t *testing.T) {
+	balance := int64(10)
+	verified := true
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getAsset", "params":["F9Lw3ki3hJKeq4xjH7dPPBXsPbfXVhMsqaNgZqKhmpAS"]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"interface":"V1_NFT","id":"F9Lw3ki3hJKeq4xjH7dPPBXsPbfXVhMsqaNgZqKhmpAS","content":{"$schema":"https://schema.metaplex.com/nft1.0.json","json_uri":"https://example.com/1.json","files":[{"uri":"https://example.com/1.png","cdn_uri":"https://cdn.example.com/1.png","mime":"image/png","quality":{"$$schema":"https://schema.example.com/quality.json"}}],"metadata":{"name":"1","description":"","symbol":"","token_standard":"NonFungible"},"links":{"external_url":"","image":"https://example.com/1.png","animation_url":"https://example.com/1.mp4"}},"grouping":[{"group_key":"collection","group_value":"J1S9H3QjnRtBbbuD4HjPV6RpRhwuk4zKbxsnCHuTgh9w","verified":true,"collection_metadata":{"name":"C","symbol":"C","image":"","description":"","external_url":""}}],"ownership":{"delegated":false,"frozen":false,"owner":"86xCnPeV69n6t3DnyGvkKobf9FdN2H9oiVDdaMpo2MMY","ownership_model":"single"},"mutable":true,"burnt":false,"token_info":{"symbol":"C","balance":10,"associated_token_address":"8LJRbnDMRBQX8Ggd2jHqN4vvxrfN7Crr9AFqVbnYEKqB","supply":1,"decimals":0,"token_program":"TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA"},"mint_extensions":{"metadata_pointer":{"authority":"86xCnPeV69n6t3DnyGvkKobf9FdN2H9oiVDdaMpo2MMY"}}},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.GetAsset(context.TODO(), "F9Lw3ki3hJKeq4xjH7dPPBXsPbfXVhMsqaNgZqKhmpAS")
+				},
+				ExpectedValue: &Asset{
+					Interface: "V1_NFT",
+					Id:        "F9Lw3ki3hJKeq4xjH7dPPBXsPbfXVhMsqaNgZqKhmpAS",
+					Content: &AssetContent{
+						Schema:  "https://schema.metaplex.com/nft1.0.json",
+						JsonUri: "https://example.com/1.json",
+						Files: []AssetFile{
+							{
+								Uri:     "https://example.com/1.png",
+								CdnUri:  "https://cdn.example.com/1.png",
+								Mime:    "image/png",
+								Quality: &AssetQuality{Schema: "https://schema.example.com/quality.json"},
+							},
+						},
+						Metadata: AssetMetadata{Name: "1", TokenStandard: "NonFungible"},
+						Links: &AssetLinks{
+							Image:        "https://example.com/1.png",
+							AnimationUrl: "https://example.com/1.mp4",
+						},
+					},
+					Grouping: []AssetGrouping{
+						{
+							GroupKey:           "collection",
+							GroupValue:         "J1S9H3QjnRtBbbuD4HjPV6RpRhwuk4zKbxsnCHuTgh9w",
+							Verified:           &verified,
+							CollectionMetadata: &AssetCollectionMetadata{Name: "C", Symbol: "C"},
+						},
+					},
+					Ownership: AssetOwnership{
+						Owner:          "86xCnPeV69n6t3DnyGvkKobf9FdN2H9oiVDdaMpo2MMY",
+						OwnershipModel: "single",
+					},
+					Mutable: true,
+					TokenInfo: &AssetTokenInfo{
+						Symbol:                 "C",
+						Balance:                &balance,
+						AssociatedTokenAddress: "8LJRbnDMRBQX8Ggd2jHqN4vvxrfN7Crr9AFqVbnYEKqB",
+						Supply:                 1,
+						TokenProgram:           "TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA",
+					},
+					MintExtensions: map[string]interface{}{
+						"metadata_pointer": map[string]interface{}{"authority": "86xCnPeV69n6t3DnyGvkKobf9FdN2H9oiVDdaMpo2MMY"},
+					},
+				},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
+
+func TestClient_GetAssetProof(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getAssetProof", "params":{"id":"Bu1DEKeawy7txbnCEJE4BU3BKLXaNAKCYcHR4XhndGss"}}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"root":"2o6Y6EiY3WXhoaEpei2pHmHLYnHDcEQVhgD89GrGHDBH","proof":["EmJXiXEAhEN3FfNQtBa5hwR8LC5kHvdLsaGCoERosZjK"],"node_index":16384,"leaf":"6YdZXw49M97mfFTwgQb6kxM2c6eqZkHSaW9XhhoZXtzv","tree_id":"2kuTFCcjbV22wvUmtmgsFR7cas7eZUzAu96jzJUvUcb7"},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.GetAssetProof(context.TODO(), "Bu1DEKeawy7txbnCEJE4BU3BKLXaNAKCYcHR4XhndGss")
+				},
+				ExpectedValue: AssetProof{
+					Root:      common.PublicKeyFromString("2o6Y6EiY3WXhoaEpei2pHmHLYnHDcEQVhgD89GrGHDBH"),
+					Proof:     []common.PublicKey{common.PublicKeyFromString("EmJXiXEAhEN3FfNQtBa5hwR8LC5kHvdLsaGCoERosZjK")},
+					NodeIndex: 16384,
+					Leaf:      common.PublicKeyFromString("6YdZXw49M97mfFTwgQb6kxM2c6eqZkHSaW9XhhoZXtzv"),
+					TreeId:    common.PublicKeyFromString("2kuTFCcjbV22wvUmtmgsFR7cas7eZUzAu96jzJUvUcb7"),
+				},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
+
+func TestClient_GetAssetsByOwnerIterator(t *testing.T) {
+	asset := func(id string) string {
+		return `{"interface":"V1_NFT","id":"` + id + `","ownership":{"delegated":false,"frozen":false,"owner":"86xCnPeV69n6t3DnyGvkKobf9FdN2H9oiVDdaMpo2MMY","ownership_model":"single"},"mutable":true,"burnt":false}`
+	}
+	ids := func(assets []Asset) []string {
+		s := []string{}
+		for _, a := range assets {
+			s = append(s, a.Id)
+		}
+		return s
+	}
+
+	t.Run("page", func(t *testing.T) {
+		server := client_test.NewMockServer(t, []client_test.Mock{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getAssetsByOwner", "params":{"ownerAddress":"86xCnPeV69n6t3DnyGvkKobf9FdN2H9oiVDdaMpo2MMY","page":1,"limit":2}}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"total":2,"limit":2,"page":1,"items":[` + asset("A") + `,` + asset("B") + `]},"id":1}`,
+			},
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getAssetsByOwner", "params":{"ownerAddress":"86xCnPeV69n6t3DnyGvkKobf9FdN2H9oiVDdaMpo2MMY","page":2,"limit":2}}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"total":1,"limit":2,"page":2,"items":[` + asset("C") + `]},"id":1}`,
+			},
+		})
+		defer server.Close()
+
+		it := NewClient(server.URL).GetAssetsByOwnerIterator(
+			"86xCnPeV69n6t3DnyGvkKobf9FdN2H9oiVDdaMpo2MMY",
+			GetAssetsByOwnerConfig{DasPagination: DasPagination{Page: 1, Limit: 2}},
+		)
+		assets, err := it.All(context.TODO())
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"A", "B", "C"}, ids(assets))
+		assert.Equal(t, 2, it.Page().Page)
+	})
+
+	t.Run("cursor", func(t *testing.T) {
+		server := client_test.NewMockServer(t, []client_test.Mock{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getAssetsByOwner", "params":{"ownerAddress":"86xCnPeV69n6t3DnyGvkKobf9FdN2H9oiVDdaMpo2MMY","limit":1}}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"total":1,"limit":1,"cursor":"c1","items":[` + asset("A") + `]},"id":1}`,
+			},
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getAssetsByOwner", "params":{"ownerAddress":"86xCnPeV69n6t3DnyGvkKobf9FdN2H9oiVDdaMpo2MMY","cursor":"c1","limit":1}}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"total":1,"limit":1,"cursor":"c2","items":[` + asset("B") + `]},"id":1}`,
+			},
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getAssetsByOwner", "params":{"ownerAddress":"86xCnPeV69n6t3DnyGvkKobf9FdN2H9oiVDdaMpo2MMY","cursor":"c2","limit":1}}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"total":0,"limit":1,"items":[]},"id":1}`,
+			},
+		})
+		defer server.Close()
+
+		it := NewClient(server.URL).GetAssetsByOwnerIterator(
+			"86xCnPeV69n6t3DnyGvkKobf9FdN2H9oiVDdaMpo2MMY",
+			GetAssetsByOwnerConfig{DasPagination: DasPagination{Limit: 1}},
+		)
+		assets, err := it.All(context.TODO())
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"A", "B"}, ids(assets))
+	})
+
+	t.Run("error", func(t *testing.T) {
+		server := client_test.NewMockServer(t, []client_test.Mock{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getAssetsByOwner", "params":{"ownerAddress":"86xCnPeV69n6t3DnyGvkKobf9FdN2H9oiVDdaMpo2MMY"}}`,
+				ResponseBody: `{"jsonrpc":"2.0","error":{"code":-32602,"message":"Invalid params"},"id":1}`,
+			},
+		})
+		defer server.Close()
+
+		it := NewClient(server.URL).GetAssetsByOwnerIterator("86xCnPeV69n6t3DnyGvkKobf9FdN2H9oiVDdaMpo2MMY", GetAssetsByOwnerConfig{})
+		assert.False(t, it.Next(context.TODO()))
+		var rpcErr *rpc.JsonRpcError
+		assert.True(t, errors.As(it.Err(), &rpcErr))
+		assert.False(t, it.Next(context.TODO()))
+	})
+}
+
+func TestClient_GetTokenAccounts(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getTokenAccounts", "params":{"owner":"86xCnPeV69n6t3DnyGvkKobf9FdN2H9oiVDdaMpo2MMY","page":1,"limit":1}}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"total":1,"limit":1,"page":1,"token_accounts":[{"address":"8LJRbnDMRBQX8Ggd2jHqN4vvxrfN7Crr9AFqVbnYEKqB","mint":"DezXAZ8z7PnrnRJjz3wXBoRgixCa6xjnB7YaB1pPB263","owner":"86xCnPeV69n6t3DnyGvkKobf9FdN2H9oiVDdaMpo2MMY","amount":5000,"delegated_amount":0,"frozen":false}]},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.GetTokenAccounts(context.TODO(), GetTokenAccountsConfig{
+						Owner:         "86xCnPeV69n6t3DnyGvkKobf9FdN2H9oiVDdaMpo2MMY",
+						DasPagination: DasPagination{Page: 1, Limit: 1},
+					})
+				},
+				ExpectedValue: DasPage[DasTokenAccount]{
+					Total: 1,
+					Limit: 1,
+					Page:  1,
+					Items: []DasTokenAccount{
+						{
+							Address: "8LJRbnDMRBQX8Ggd2jHqN4vvxrfN7Crr9AFqVbnYEKqB",
+							Mint:    "DezXAZ8z7PnrnRJjz3wXBoRgixCa6xjnB7YaB1pPB263",
+							Owner:   "86xCnPeV69n6t3DnyGvkKobf9FdN2H9oiVDdaMpo2MMY",
+							Amount:  5000,
+						},
+					},
+				},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
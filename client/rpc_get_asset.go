@@ -21,6 +21,8 @@ type Asset struct {
 	Mutable     bool
 	Burnt       bool
 	TokenInfo   *AssetTokenInfo
+	// MintExtensions are the token-2022 extensions of the asset's mint
+	MintExtensions map[string]interface{}
 }
 
 type AssetContent struct {
@@ -33,13 +35,14 @@ type AssetContent struct {
 
 type AssetFile struct {
 	Uri      string
+	CdnUri   string
 	Mime     string
 	Quality  *AssetQuality
 	Contexts []string
 }
 
 type AssetQuality struct {
-	// Add quality fields as needed
+	Schema string
 }
 
 type AssetMetadata struct {
@@ -56,8 +59,9 @@ type AssetAttribute struct {
 }
 
 type AssetLinks struct {
-	ExternalUrl string
-	Image       string
+	ExternalUrl  string
+	Image        string
+	AnimationUrl string
 }
 
 type AssetAuthority struct {
@@ -79,6 +83,18 @@ type AssetCompression struct {
 type AssetGrouping struct {
 	GroupKey   string // e.g., "collection"
 	GroupValue string
+	// Verified is only returned with the showUnverifiedCollections option
+	Verified *bool
+	// CollectionMetadata is only returned with the showCollectionMetadata option
+	CollectionMetadata *AssetCollectionMetadata
+}
+
+type AssetCollectionMetadata struct {
+	Name        string
+	Symbol      string
+	Image       string
+	Description string
+	ExternalUrl string
 }
 
 type AssetRoyalty struct {
@@ -117,12 +133,14 @@ type AssetSupply struct {
 }
 
 type AssetTokenInfo struct {
-	Supply          int64
-	Decimals        int
-	TokenProgram    string
-	MintAuthority   string
-	FreezeAuthority string
-	MintExtensions  map[string]interface{}
+	Symbol                 string
+	Balance                *int64
+	AssociatedTokenAddress string
+	Supply                 int64
+	Decimals               int
+	TokenProgram           string
+	MintAuthority          string
+	FreezeAuthority        string
 }
 
 func (c *Client) GetAsset(ctx context.Context, assetId string) (*Asset, error) {
@@ -154,6 +172,8 @@ func convertAsset(v rpc.Asset) (*Asset, error) {
 		Mutable:     v.Mutable,
 		Burnt:       v.Burnt,
 		TokenInfo:   convertAssetTokenInfo(v.TokenInfo),
+
+		MintExtensions: v.MintExtensions,
 	}, nil
 }
 
@@ -178,6 +198,7 @@ func convertAssetFiles(v []rpc.AssetFile) []AssetFile {
 	for i, file := range v {
 		files[i] = AssetFile{
 			Uri:      file.Uri,
+			CdnUri:   file.CdnUri,
 			Mime:     file.Mime,
 			Quality:  convertAssetQuality(file.Quality),
 			Contexts: file.Contexts,
@@ -201,8 +222,9 @@ func convertAssetLinks(v *rpc.AssetLinks) *AssetLinks {
 		return nil
 	}
 	return &AssetLinks{
-		ExternalUrl: v.ExternalUrl,
-		Image:       v.Image,
+		ExternalUrl:  v.ExternalUrl,
+		Image:        v.Image,
+		AnimationUrl: v.AnimationUrl,
 	}
 }
 
@@ -243,13 +265,28 @@ func convertAssetGrouping(v []rpc.AssetGrouping) []AssetGrouping {
 	groupings := make([]AssetGrouping, len(v))
 	for i, group := range v {
 		groupings[i] = AssetGrouping{
-			GroupKey:   group.GroupKey,
-			GroupValue: group.GroupValue,
+			GroupKey:           group.GroupKey,
+			GroupValue:         group.GroupValue,
+			Verified:           group.Verified,
+			CollectionMetadata: convertAssetCollectionMetadata(group.CollectionMetadata),
 		}
 	}
 	return groupings
 }
 
+func convertAssetCollectionMetadata(v *rpc.AssetCollectionMetadata) *AssetCollectionMetadata {
+	if v == nil {
+		return nil
+	}
+	return &AssetCollectionMetadata{
+		Name:        v.Name,
+		Symbol:      v.Symbol,
+		Image:       v.Image,
+		Description: v.Description,
+		ExternalUrl: v.ExternalUrl,
+	}
+}
+
 func convertAssetRoyalty(v *rpc.AssetRoyalty) *AssetRoyalty {
 	if v == nil {
 		return nil
@@ -316,12 +353,14 @@ func convertAssetTokenInfo(v *rpc.AssetTokenInfo) *AssetTokenInfo {
 		return nil
 	}
 	return &AssetTokenInfo{
-		Supply:          v.Supply,
-		Decimals:        v.Decimals,
-		TokenProgram:    v.TokenProgram,
-		MintAuthority:   v.MintAuthority,
-		FreezeAuthority: v.FreezeAuthority,
-		MintExtensions:  v.MintExtensions,
+		Symbol:                 v.Symbol,
+		Balance:                v.Balance,
+		AssociatedTokenAddress: v.AssociatedTokenAddress,
+		Supply:                 v.Supply,
+		Decimals:               v.Decimals,
+		TokenProgram:           v.TokenProgram,
+		MintAuthority:          v.MintAuthority,
+		FreezeAuthority:        v.FreezeAuthority,
 	}
 }
 
@@ -343,5 +382,7 @@ func convertAssetQuality(v *rpc.AssetQuality) *AssetQuality {
 	if v == nil {
 		return nil
 	}
-	return &AssetQuality{}
+	return &AssetQuality{
+		Schema: v.Schema,
+	}
 }
@@ -0,0 +1,31 @@
+package client
+
+import (
+	"context"
+
+	"github.com/qimeila/solana-go-sdk/rpc"
+)
+
+// GetAssetBatch returns assets in the order of assetIds, an asset which doesn't exist is nil
+func (c *Client) GetAssetBatch(ctx context.Context, assetIds []string) ([]*Asset, error) {
+	return process(
+		func() (rpc.JsonRpcResponse[[]*rpc.Asset], error) {
+			return c.RpcClient.GetAssetBatch(ctx, assetIds)
+		},
+		func(v []*rpc.Asset) ([]*Asset, error) {
+			assets := make([]*Asset, 0, len(v))
+			for _, a := range v {
+				if a == nil {
+					assets = append(assets, nil)
+					continue
+				}
+				asset, err := convertAsset(*a)
+				if err != nil {
+					return nil, err
+				}
+				assets = append(assets, asset)
+			}
+			return assets, nil
+		},
+	)
+}
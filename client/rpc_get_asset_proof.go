@@ -0,0 +1,63 @@
+package client
+
+import (
+	"context"
+
+	"github.com/qimeila/solana-go-sdk/common"
+	"github.com/qimeila/solana-go-sdk/rpc"
+)
+
+// AssetProof is the merkle proof of a compressed asset, Proof starts from the leaf's sibling
+type AssetProof struct {
+	Root      common.PublicKey
+	Proof     []common.PublicKey
+	NodeIndex int64
+	Leaf      common.PublicKey
+	TreeId    common.PublicKey
+}
+
+func (c *Client) GetAssetProof(ctx context.Context, assetId string) (AssetProof, error) {
+	return process(
+		func() (rpc.JsonRpcResponse[rpc.AssetProof], error) {
+			return c.RpcClient.GetAssetProof(ctx, assetId)
+		},
+		convertAssetProof,
+	)
+}
+
+func (c *Client) GetAssetProofBatch(ctx context.Context, assetIds []string) (map[string]*AssetProof, error) {
+	return process(
+		func() (rpc.JsonRpcResponse[map[string]*rpc.AssetProof], error) {
+			return c.RpcClient.GetAssetProofBatch(ctx, assetIds)
+		},
+		func(v map[string]*rpc.AssetProof) (map[string]*AssetProof, error) {
+			proofs := make(map[string]*AssetProof, len(v))
+			for id, proof := range v {
+				if proof == nil {
+					proofs[id] = nil
+					continue
+				}
+				p, err := convertAssetProof(*proof)
+				if err != nil {
+					return nil, err
+				}
+				proofs[id] = &p
+			}
+			return proofs, nil
+		},
+	)
+}
+
+func convertAssetProof(v rpc.AssetProof) (AssetProof, error) {
+	proof := make([]common.PublicKey, 0, len(v.Proof))
+	for _, node := range v.Proof {
+		proof = append(proof, common.PublicKeyFromString(node))
+	}
+	return AssetProof{
+		Root:      common.PublicKeyFromString(v.Root),
+		Proof:     proof,
+		NodeIndex: v.NodeIndex,
+		Leaf:      common.PublicKeyFromString(v.Leaf),
+		TreeId:    common.PublicKeyFromString(v.TreeId),
+	}, nil
+}
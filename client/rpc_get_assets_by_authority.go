@@ -0,0 +1,26 @@
+package client
+
+import (
+	"context"
+
+	"github.com/qimeila/solana-go-sdk/rpc"
+)
+
+type GetAssetsByAuthorityConfig = rpc.GetAssetsByAuthorityConfig
+
+func (c *Client) GetAssetsByAuthority(ctx context.Context, authorityAddress string, cfg GetAssetsByAuthorityConfig) (DasPage[Asset], error) {
+	return process(
+		func() (rpc.JsonRpcResponse[rpc.AssetList], error) {
+			return c.RpcClient.GetAssetsByAuthority(ctx, authorityAddress, cfg)
+		},
+		convertAssetList,
+	)
+}
+
+// GetAssetsByAuthorityIterator walks all pages starting from the one cfg selects
+func (c *Client) GetAssetsByAuthorityIterator(authorityAddress string, cfg GetAssetsByAuthorityConfig) *DasIterator[Asset] {
+	return newDasIterator(cfg.DasPagination, func(ctx context.Context, pagination DasPagination) (DasPage[Asset], error) {
+		cfg.DasPagination = pagination
+		return c.GetAssetsByAuthority(ctx, authorityAddress, cfg)
+	})
+}
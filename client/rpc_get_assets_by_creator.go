@@ -0,0 +1,26 @@
+package client
+
+import (
+	"context"
+
+	"github.com/qimeila/solana-go-sdk/rpc"
+)
+
+type GetAssetsByCreatorConfig = rpc.GetAssetsByCreatorConfig
+
+func (c *Client) GetAssetsByCreator(ctx context.Context, creatorAddress string, cfg GetAssetsByCreatorConfig) (DasPage[Asset], error) {
+	return process(
+		func() (rpc.JsonRpcResponse[rpc.AssetList], error) {
+			return c.RpcClient.GetAssetsByCreator(ctx, creatorAddress, cfg)
+		},
+		convertAssetList,
+	)
+}
+
+// GetAssetsByCreatorIterator walks all pages starting from the one cfg selects
+func (c *Client) GetAssetsByCreatorIterator(creatorAddress string, cfg GetAssetsByCreatorConfig) *DasIterator[Asset] {
+	return newDasIterator(cfg.DasPagination, func(ctx context.Context, pagination DasPagination) (DasPage[Asset], error) {
+		cfg.DasPagination = pagination
+		return c.GetAssetsByCreator(ctx, creatorAddress, cfg)
+	})
+}
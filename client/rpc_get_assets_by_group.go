@@ -0,0 +1,26 @@
+package client
+
+import (
+	"context"
+
+	"github.com/qimeila/solana-go-sdk/rpc"
+)
+
+type GetAssetsByGroupConfig = rpc.GetAssetsByGroupConfig
+
+func (c *Client) GetAssetsByGroup(ctx context.Context, groupKey, groupValue string, cfg GetAssetsByGroupConfig) (DasPage[Asset], error) {
+	return process(
+		func() (rpc.JsonRpcResponse[rpc.AssetList], error) {
+			return c.RpcClient.GetAssetsByGroup(ctx, groupKey, groupValue, cfg)
+		},
+		convertAssetList,
+	)
+}
+
+// GetAssetsByGroupIterator walks all pages starting from the one cfg selects
+func (c *Client) GetAssetsByGroupIterator(groupKey, groupValue string, cfg GetAssetsByGroupConfig) *DasIterator[Asset] {
+	return newDasIterator(cfg.DasPagination, func(ctx context.Context, pagination DasPagination) (DasPage[Asset], error) {
+		cfg.DasPagination = pagination
+		return c.GetAssetsByGroup(ctx, groupKey, groupValue, cfg)
+	})
+}
@@ -0,0 +1,26 @@
+package client
+
+import (
+	"context"
+
+	"github.com/qimeila/solana-go-sdk/rpc"
+)
+
+type GetAssetsByOwnerConfig = rpc.GetAssetsByOwnerConfig
+
+func (c *Client) GetAssetsByOwner(ctx context.Context, ownerAddress string, cfg GetAssetsByOwnerConfig) (DasPage[Asset], error) {
+	return process(
+		func() (rpc.JsonRpcResponse[rpc.AssetList], error) {
+			return c.RpcClient.GetAssetsByOwner(ctx, ownerAddress, cfg)
+		},
+		convertAssetList,
+	)
+}
+
+// GetAssetsByOwnerIterator walks all pages starting from the one cfg selects
+func (c *Client) GetAssetsByOwnerIterator(ownerAddress string, cfg GetAssetsByOwnerConfig) *DasIterator[Asset] {
+	return newDasIterator(cfg.DasPagination, func(ctx context.Context, pagination DasPagination) (DasPage[Asset], error) {
+		cfg.DasPagination = pagination
+		return c.GetAssetsByOwner(ctx, ownerAddress, cfg)
+	})
+}
@@ -0,0 +1,29 @@
+package client
+
+import (
+	"context"
+
+	"github.com/qimeila/solana-go-sdk/rpc"
+)
+
+type AssetSignature = rpc.AssetSignature
+type GetSignaturesForAssetConfig = rpc.GetSignaturesForAssetConfig
+
+func (c *Client) GetSignaturesForAsset(ctx context.Context, assetId string, cfg GetSignaturesForAssetConfig) (DasPage[AssetSignature], error) {
+	return process(
+		func() (rpc.JsonRpcResponse[rpc.DasPage[rpc.AssetSignature]], error) {
+			return c.RpcClient.GetSignaturesForAsset(ctx, assetId, cfg)
+		},
+		func(v rpc.DasPage[rpc.AssetSignature]) (DasPage[AssetSignature], error) {
+			return convertDasPage(v, forward[AssetSignature])
+		},
+	)
+}
+
+// GetSignaturesForAssetIterator walks all pages starting from the one cfg selects
+func (c *Client) GetSignaturesForAssetIterator(assetId string, cfg GetSignaturesForAssetConfig) *DasIterator[AssetSignature] {
+	return newDasIterator(cfg.DasPagination, func(ctx context.Context, pagination DasPagination) (DasPage[AssetSignature], error) {
+		cfg.DasPagination = pagination
+		return c.GetSignaturesForAsset(ctx, assetId, cfg)
+	})
+}
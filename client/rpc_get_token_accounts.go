@@ -0,0 +1,29 @@
+package client
+
+import (
+	"context"
+
+	"github.com/qimeila/solana-go-sdk/rpc"
+)
+
+type DasTokenAccount = rpc.DasTokenAccount
+type GetTokenAccountsConfig = rpc.GetTokenAccountsConfig
+
+func (c *Client) GetTokenAccounts(ctx context.Context, cfg GetTokenAccountsConfig) (DasPage[DasTokenAccount], error) {
+	return process(
+		func() (rpc.JsonRpcResponse[rpc.DasTokenAccountList], error) {
+			return c.RpcClient.GetTokenAccounts(ctx, cfg)
+		},
+		func(v rpc.DasTokenAccountList) (DasPage[DasTokenAccount], error) {
+			return convertDasPage(rpc.DasPage[rpc.DasTokenAccount](v), forward[DasTokenAccount])
+		},
+	)
+}
+
+// GetTokenAccountsIterator walks all pages starting from the one cfg selects
+func (c *Client) GetTokenAccountsIterator(cfg GetTokenAccountsConfig) *DasIterator[DasTokenAccount] {
+	return newDasIterator(cfg.DasPagination, func(ctx context.Context, pagination DasPagination) (DasPage[DasTokenAccount], error) {
+		cfg.DasPagination = pagination
+		return c.GetTokenAccounts(ctx, cfg)
+	})
+}
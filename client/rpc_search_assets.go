@@ -0,0 +1,26 @@
+package client
+
+import (
+	"context"
+
+	"github.com/qimeila/solana-go-sdk/rpc"
+)
+
+type SearchAssetsConfig = rpc.SearchAssetsConfig
+
+func (c *Client) SearchAssets(ctx context.Context, cfg SearchAssetsConfig) (DasPage[Asset], error) {
+	return process(
+		func() (rpc.JsonRpcResponse[rpc.AssetList], error) {
+			return c.RpcClient.SearchAssets(ctx, cfg)
+		},
+		convertAssetList,
+	)
+}
+
+// SearchAssetsIterator walks all pages starting from the one cfg selects
+func (c *Client) SearchAssetsIterator(cfg SearchAssetsConfig) *DasIterator[Asset] {
+	return newDasIterator(cfg.DasPagination, func(ctx context.Context, pagination DasPagination) (DasPage[Asset], error) {
+		cfg.DasPagination = pagination
+		return c.SearchAssets(ctx, cfg)
+	})
+}
@@ -0,0 +1,46 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/qimeila/solana-go-sdk/common"
+	"github.com/qimeila/solana-go-sdk/program/stake"
+	"github.com/qimeila/solana-go-sdk/program/sysvar"
+)
+
+func (c *Client) GetStakeAccount(ctx context.Context, base58Addr string) (stake.StakeStateV2, error) {
+	accountInfo, err := c.GetAccountInfo(ctx, base58Addr)
+	if err != nil {
+		return stake.StakeStateV2{}, err
+	}
+	return stake.DeserializeStakeStateV2(accountInfo.Data, accountInfo.Owner)
+}
+
+// GetStakeActivation computes a stake account's activation for the current epoch locally.
+// newRateActivationEpoch is the epoch the reduce_stake_warmup_cooldown feature was activated on the cluster, nil if it isn't.
+func (c *Client) GetStakeActivation(ctx context.Context, base58Addr string, newRateActivationEpoch *uint64) (stake.StakeActivation, error) {
+	accountInfos, err := c.GetMultipleAccounts(ctx, []string{base58Addr, common.SysVarStakeHistoryPubkey.ToBase58()})
+	if err != nil {
+		return stake.StakeActivation{}, err
+	}
+	if len(accountInfos) != 2 {
+		return stake.StakeActivation{}, fmt.Errorf("unexpected number of accounts, got: %v", len(accountInfos))
+	}
+
+	state, err := stake.DeserializeStakeStateV2(accountInfos[0].Data, accountInfos[0].Owner)
+	if err != nil {
+		return stake.StakeActivation{}, fmt.Errorf("failed to deserialize stake account, err: %w", err)
+	}
+	history, err := sysvar.DeserializeStakeHistory(accountInfos[1].Data, accountInfos[1].Owner)
+	if err != nil {
+		return stake.StakeActivation{}, fmt.Errorf("failed to deserialize stake history, err: %w", err)
+	}
+
+	epochInfo, err := c.GetEpochInfo(ctx)
+	if err != nil {
+		return stake.StakeActivation{}, fmt.Errorf("failed to get epoch info, err: %w", err)
+	}
+
+	return stake.GetStakeActivation(state, accountInfos[0].Lamports, epochInfo.Epoch, history, newRateActivationEpoch), nil
+}
@@ -0,0 +1,33 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/qimeila/solana-go-sdk/internal/client_test"
+	"github.com/qimeila/solana-go-sdk/program/stake"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_GetStakeActivation(t *testing.T) {
+	server := client_test.NewMockServer(t, []client_test.Mock{
+		{
+			RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getMultipleAccounts", "params":[["FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm", "SysvarStakeHistory1111111111111111111111111"], {"encoding": "base64"}]}`,
+			ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"apiVersion":"1.14.10","slot":4752000},"value":[{"data":["AgAAAIDVIgAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA6AMAAAAAAAAKAAAAAAAAAP//////////AAAAAAAA0D8AAAAAAAAAAAAAAAA=","base64"],"executable":false,"lamports":2283885,"owner":"Stake11111111111111111111111111111111111111","rentEpoch":0},{"data":["AQAAAAAAAAAKAAAAAAAAANAHAAAAAAAA6AMAAAAAAAAAAAAAAAAAAA==","base64"],"executable":false,"lamports":114979200,"owner":"Sysvar1111111111111111111111111111111111111","rentEpoch":0}]},"id":1}`,
+		},
+		{
+			RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getEpochInfo"}`,
+			ResponseBody: `{"jsonrpc":"2.0","result":{"absoluteSlot":4752000,"blockHeight":4752000,"epoch":11,"slotIndex":0,"slotsInEpoch":432000,"transactionCount":1},"id":1}`,
+		},
+	})
+	defer server.Close()
+
+	c := NewClient(server.URL)
+	got, err := c.GetStakeActivation(context.Background(), "FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, stake.StakeActivation{
+		State:    stake.StakeActivationStateActivating,
+		Active:   500,
+		Inactive: 505,
+	}, got)
+}
@@ -0,0 +1,22 @@
+package client
+
+import (
+	"context"
+
+	"github.com/qimeila/solana-go-sdk/program/sysvar"
+)
+
+// GetRent fetches the current rent parameters, use it to refresh an offline sysvar.Rent calculator
+func (c *Client) GetRent(ctx context.Context) (sysvar.Rent, error) {
+	return GetSysvar[sysvar.Rent](ctx, c)
+}
+
+// GetSysvar fetches and decodes sysvar T, e.g. GetSysvar[sysvar.Clock](ctx, c)
+func GetSysvar[T sysvar.Sysvar](ctx context.Context, c *Client) (T, error) {
+	accountInfo, err := c.GetAccountInfo(ctx, sysvar.PubkeyOf[T]().ToBase58())
+	if err != nil {
+		var v T
+		return v, err
+	}
+	return sysvar.Deserialize[T](accountInfo.Data, accountInfo.Owner)
+}
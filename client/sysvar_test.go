@@ -0,0 +1,55 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/qimeila/solana-go-sdk/internal/client_test"
+	"github.com/qimeila/solana-go-sdk/program/sysvar"
+)
+
+func TestClient_GetRent(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getAccountInfo", "params":["SysvarRent111111111111111111111111111111111", {"encoding": "base64"}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"apiVersion":"1.14.17","slot":200000000},"value":{"data":["mA0AAAAAAAAAAAAAAAAAQDI=","base64"],"executable":false,"lamports":1009200,"owner":"Sysvar1111111111111111111111111111111111111","rentEpoch":0}},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.GetRent(context.Background())
+				},
+				ExpectedValue: sysvar.Rent{
+					LamportsPerByteYear: 3480,
+					ExemptionThreshold:  2.0,
+					BurnPercent:         50,
+				},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
+
+func TestGetSysvar(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getAccountInfo", "params":["SysvarC1ock11111111111111111111111111111111", {"encoding": "base64"}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"apiVersion":"1.14.17","slot":215125216},"value":{"data":["4IzSDAAAAAAA8VNlAAAAAPQBAAAAAAAA9QEAAAAAAAAQ9VNlAAAAAA==","base64"],"executable":false,"lamports":1169280,"owner":"Sysvar1111111111111111111111111111111111111","rentEpoch":0}},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return GetSysvar[sysvar.Clock](context.Background(), c)
+				},
+				ExpectedValue: sysvar.Clock{
+					Slot:                215125216,
+					EpochStartTimestamp: 1700000000,
+					Epoch:               500,
+					LeaderScheduleEpoch: 501,
+					UnixTimestamp:       1700001040,
+				},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
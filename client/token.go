@@ -2,8 +2,12 @@ package client
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/qimeila/solana-go-sdk/common"
+	"github.com/qimeila/solana-go-sdk/program/associated_token_account"
 	"github.com/qimeila/solana-go-sdk/program/token"
+	"github.com/qimeila/solana-go-sdk/types"
 )
 
 func (c *Client) GetTokenAccount(ctx context.Context, base58Addr string) (token.TokenAccount, error) {
@@ -13,3 +17,129 @@ func (c *Client) GetTokenAccount(ctx context.Context, base58Addr string) (token.
 	}
 	return token.DeserializeTokenAccount(accountInfo.Data, accountInfo.Owner)
 }
+
+type TransferTokenParam struct {
+	Mint common.PublicKey
+	// From is the wallet which owns the source associated token account
+	From common.PublicKey
+	// To is the receiver's wallet, its associated token account is created if it doesn't exist
+	To common.PublicKey
+	// Amount is an ui amount, e.g. "1.5"
+	Amount string
+	// FeePayer funds the receiver's associated token account, default is From
+	FeePayer common.PublicKey
+}
+
+// TransferTokenInstructions builds the instructions to send a ui amount of an spl token to a wallet.
+// It detects whether the mint belongs to the token program or Token-2022 and creates the receiver's
+// associated token account when it is missing.
+func (c *Client) TransferTokenInstructions(ctx context.Context, param TransferTokenParam) ([]types.Instruction, error) {
+	feePayer := param.FeePayer
+	if feePayer == (common.PublicKey{}) {
+		feePayer = param.From
+	}
+
+	mintAccountInfo, err := c.GetAccountInfo(ctx, param.Mint.ToBase58())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get mint account, err: %v", err)
+	}
+	if mintAccountInfo.Owner != common.TokenProgramID && mintAccountInfo.Owner != common.Token2022ProgramID {
+		return nil, fmt.Errorf("mint %v is not owned by a token program, owner: %v", param.Mint, mintAccountInfo.Owner)
+	}
+	tokenProgramID := mintAccountInfo.Owner
+
+	mint, err := token.DeserializeMintAccount(mintAccountInfo.Data, tokenProgramID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to deserialize mint account, err: %v", err)
+	}
+	amount, err := token.ParseTokenAmount(param.Amount, mint.Decimals)
+	if err != nil {
+		return nil, err
+	}
+
+	fromAta, _, err := associated_token_account.GetAssociatedTokenAddress(param.From, param.Mint, tokenProgramID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find associated token address, err: %v", err)
+	}
+	toAta, _, err := associated_token_account.GetAssociatedTokenAddress(param.To, param.Mint, tokenProgramID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find associated token address, err: %v", err)
+	}
+
+	instructions := make([]types.Instruction, 0, 2)
+
+	toAtaInfo, err := c.GetAccountInfo(ctx, toAta.ToBase58())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get receiver's token account, err: %v", err)
+	}
+	if toAtaInfo.Owner == (common.PublicKey{}) {
+		instructions = append(instructions, associated_token_account.CreateIdempotent(associated_token_account.CreateIdempotentParam{
+			Funder:                 feePayer,
+			Owner:                  param.To,
+			Mint:                   param.Mint,
+			AssociatedTokenAccount: toAta,
+			TokenProgramID:         tokenProgramID,
+		}))
+	}
+
+	instructions = append(instructions, token.TransferChecked(token.TransferCheckedParam{
+		From:        fromAta,
+		To:          toAta,
+		Mint:        param.Mint,
+		Auth:        param.From,
+		Signers:     []common.PublicKey{},
+		TokenAmount: &amount,
+		ProgramID:   tokenProgramID,
+	}))
+
+	return instructions, nil
+}
+
+// TransferTokenMessage is TransferTokenInstructions packed into a message with the latest blockhash, ready to sign.
+func (c *Client) TransferTokenMessage(ctx context.Context, param TransferTokenParam) (types.Message, error) {
+	instructions, err := c.TransferTokenInstructions(ctx, param)
+	if err != nil {
+		return types.Message{}, err
+	}
+
+	recentBlockhashRes, err := c.GetLatestBlockhash(ctx)
+	if err != nil {
+		return types.Message{}, fmt.Errorf("failed to get recent blockhash, err: %v", err)
+	}
+
+	feePayer := param.FeePayer
+	if feePayer == (common.PublicKey{}) {
+		feePayer = param.From
+	}
+	return types.NewMessage(types.NewMessageParam{
+		FeePayer:        feePayer,
+		RecentBlockhash: recentBlockhashRes.Blockhash,
+		Instructions:    instructions,
+	}), nil
+}
+
+// GetMultisigAccount fetches a token multisig account
+func (c *Client) GetMultisigAccount(ctx context.Context, base58Addr string) (token.MultisigAccount, error) {
+	accountInfo, err := c.GetAccountInfo(ctx, base58Addr)
+	if err != nil {
+		return token.MultisigAccount{}, err
+	}
+	return token.DeserializeMultisigAccount(accountInfo.Data, accountInfo.Owner)
+}
+
+// MultisigTokenInstructionBuilder builds a token instruction, e.g. token.TransferChecked, with auth as
+// the authority and signers as the signer metas.
+type MultisigTokenInstructionBuilder func(auth common.PublicKey, signers []common.PublicKey) types.Instruction
+
+// NewMultisigTokenInstruction fetches the multisig, checks the signers are members and meet its threshold, then
+// builds the instruction with the multisig as a non-signer authority followed by the signers.
+func (c *Client) NewMultisigTokenInstruction(ctx context.Context, multisig common.PublicKey, signers []common.PublicKey, build MultisigTokenInstructionBuilder) (types.Instruction, error) {
+	multisigAccount, err := c.GetMultisigAccount(ctx, multisig.ToBase58())
+	if err != nil {
+		return types.Instruction{}, fmt.Errorf("failed to get multisig account, err: %w", err)
+	}
+	if err := multisigAccount.ValidateSigners(signers); err != nil {
+		return types.Instruction{}, err
+	}
+	return build(multisig, signers), nil
+}
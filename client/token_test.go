@@ -0,0 +1,201 @@
+package client
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/qimeila/solana-go-sdk/common"
+	"github.com/qimeila/solana-go-sdk/internal/client_test"
+	"github.com/qimeila/solana-go-sdk/program/associated_token_account"
+	"github.com/qimeila/solana-go-sdk/program/token"
+	"github.com/qimeila/solana-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_TransferTokenInstructions(t *testing.T) {
+	from := common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7")
+	to := common.PublicKeyFromString("27kVX7JpPZ1bsrSckbR76mV6GeRqtrjoddubfg2zBpHZ")
+	mint := common.PublicKeyFromString("F5RYi7FMPefkc7okJNh21Hcsch7RUaLVr8Rzc8SQqxUb")
+
+	getAccountInfoRequest := `{"jsonrpc":"2.0", "id":1, "method":"getAccountInfo", "params":["%v", {"encoding": "base64"}]}`
+	emptyAccountResponse := `{"jsonrpc":"2.0","result":{"context":{"apiVersion":"1.14.10","slot":187539624},"value":null},"id":1}`
+
+	t.Run("token program, receiver without ata", func(t *testing.T) {
+		fromAta, _, _ := associated_token_account.GetAssociatedTokenAddress(from, mint, common.TokenProgramID)
+		toAta, _, _ := associated_token_account.GetAssociatedTokenAddress(to, mint, common.TokenProgramID)
+
+		server := client_test.NewMockServer(t, []client_test.Mock{
+			{
+				RequestBody:  fmt.Sprintf(getAccountInfoRequest, mint),
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"apiVersion":"1.14.10","slot":187539624},"value":{"data":["AQAAAAY+cNmRV5jco+7bkTfPZMcP+vtizdOCgQUlC9drHWzeAAAAAAAAAAAJAQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA==","base64"],"executable":false,"lamports":1461600,"owner":"TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA","rentEpoch":371}},"id":1}`,
+			},
+			{
+				RequestBody:  fmt.Sprintf(getAccountInfoRequest, toAta),
+				ResponseBody: emptyAccountResponse,
+			},
+		})
+		defer server.Close()
+
+		c := NewClient(server.URL)
+		got, err := c.TransferTokenInstructions(context.Background(), TransferTokenParam{
+			Mint:   mint,
+			From:   from,
+			To:     to,
+			Amount: "1.5",
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, []types.Instruction{
+			associated_token_account.CreateIdempotent(associated_token_account.CreateIdempotentParam{
+				Funder:                 from,
+				Owner:                  to,
+				Mint:                   mint,
+				AssociatedTokenAccount: toAta,
+			}),
+			token.TransferChecked(token.TransferCheckedParam{
+				From:     fromAta,
+				To:       toAta,
+				Mint:     mint,
+				Auth:     from,
+				Signers:  []common.PublicKey{},
+				Amount:   1_500_000_000,
+				Decimals: 9,
+			}),
+		}, got)
+	})
+
+	t.Run("token-2022, receiver has ata", func(t *testing.T) {
+		fromAta, _, _ := associated_token_account.GetAssociatedTokenAddress(from, mint, common.Token2022ProgramID)
+		toAta, _, _ := associated_token_account.GetAssociatedTokenAddress(to, mint, common.Token2022ProgramID)
+
+		server := client_test.NewMockServer(t, []client_test.Mock{
+			{
+				RequestBody:  fmt.Sprintf(getAccountInfoRequest, mint),
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"apiVersion":"1.14.10","slot":187539624},"value":{"data":["AQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAGAQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAQAAAAA=","base64"],"executable":false,"lamports":2178240,"owner":"TokenzQdBNbLqP5VEhdkAS6EPFLC1PHnBqCXEpPxuEb","rentEpoch":0}},"id":1}`,
+			},
+			{
+				RequestBody:  fmt.Sprintf(getAccountInfoRequest, toAta),
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"apiVersion":"1.14.10","slot":187539624},"value":{"data":["","base64"],"executable":false,"lamports":2074080,"owner":"TokenzQdBNbLqP5VEhdkAS6EPFLC1PHnBqCXEpPxuEb","rentEpoch":0}},"id":1}`,
+			},
+		})
+		defer server.Close()
+
+		c := NewClient(server.URL)
+		got, err := c.TransferTokenInstructions(context.Background(), TransferTokenParam{
+			Mint:   mint,
+			From:   from,
+			To:     to,
+			Amount: "0.000001",
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, []types.Instruction{
+			token.TransferChecked(token.TransferCheckedParam{
+				From:      fromAta,
+				To:        toAta,
+				Mint:      mint,
+				Auth:      from,
+				Signers:   []common.PublicKey{},
+				Amount:    1,
+				Decimals:  6,
+				ProgramID: common.Token2022ProgramID,
+			}),
+		}, got)
+	})
+
+	t.Run("too many decimals", func(t *testing.T) {
+		server := client_test.NewMockServer(t, []client_test.Mock{
+			{
+				RequestBody:  fmt.Sprintf(getAccountInfoRequest, mint),
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"apiVersion":"1.14.10","slot":187539624},"value":{"data":["AQAAAAY+cNmRV5jco+7bkTfPZMcP+vtizdOCgQUlC9drHWzeAAAAAAAAAAAJAQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA==","base64"],"executable":false,"lamports":1461600,"owner":"TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA","rentEpoch":371}},"id":1}`,
+			},
+		})
+		defer server.Close()
+
+		c := NewClient(server.URL)
+		_, err := c.TransferTokenInstructions(context.Background(), TransferTokenParam{
+			Mint:   mint,
+			From:   from,
+			To:     to,
+			Amount: "0.0000000001",
+		})
+		assert.ErrorIs(t, err, token.ErrInvalidTokenAmount)
+	})
+}
+
+func TestClient_NewMultisigTokenInstruction(t *testing.T) {
+	multisig := common.PublicKeyFromString("7qgRLLVn5t8ZasyxYuGhJEtTK5GKUYTYSrHuMWiwXw4N")
+	signer1 := common.PublicKeyFromString("S1gner1111111111111111111111111111111111111")
+	signer2 := common.PublicKeyFromString("S1gner2111111111111111111111111111111111111")
+	signer3 := common.PublicKeyFromString("S1gner3111111111111111111111111111111111111")
+	outsider := common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7")
+
+	// 2 of 3
+	data := make([]byte, token.MultisigAccountSize)
+	data[0], data[1], data[2] = 2, 3, 1
+	copy(data[3:], signer1.Bytes())
+	copy(data[35:], signer2.Bytes())
+	copy(data[67:], signer3.Bytes())
+
+	from := common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm")
+	to := common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ")
+	build := func(auth common.PublicKey, signers []common.PublicKey) types.Instruction {
+		return token.Transfer(token.TransferParam{
+			From:    from,
+			To:      to,
+			Auth:    auth,
+			Signers: signers,
+			Amount:  1,
+		})
+	}
+
+	tests := []struct {
+		name    string
+		signers []common.PublicKey
+		want    types.Instruction
+		wantErr error
+	}{
+		{
+			signers: []common.PublicKey{signer1, signer3},
+			want: types.Instruction{
+				ProgramID: common.TokenProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: from, IsSigner: false, IsWritable: true},
+					{PubKey: to, IsSigner: false, IsWritable: true},
+					{PubKey: multisig, IsSigner: false, IsWritable: false},
+					{PubKey: signer1, IsSigner: true, IsWritable: false},
+					{PubKey: signer3, IsSigner: true, IsWritable: false},
+				},
+				Data: []byte{3, 1, 0, 0, 0, 0, 0, 0, 0},
+			},
+		},
+		{
+			signers: []common.PublicKey{signer2},
+			wantErr: token.ErrMultisigThresholdNotMet,
+		},
+		{
+			signers: []common.PublicKey{signer2, signer2},
+			wantErr: token.ErrMultisigDuplicateSigner,
+		},
+		{
+			signers: []common.PublicKey{signer1, outsider},
+			wantErr: token.ErrMultisigSignerNotMember,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := client_test.NewMockServer(t, []client_test.Mock{
+				{
+					RequestBody:  fmt.Sprintf(`{"jsonrpc":"2.0", "id":1, "method":"getAccountInfo", "params":["%v", {"encoding": "base64"}]}`, multisig),
+					ResponseBody: fmt.Sprintf(`{"jsonrpc":"2.0","result":{"context":{"apiVersion":"1.14.10","slot":187539624},"value":{"data":["%v","base64"],"executable":false,"lamports":3361680,"owner":"TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA","rentEpoch":0}},"id":1}`, base64.StdEncoding.EncodeToString(data)),
+				},
+			})
+			defer server.Close()
+
+			c := NewClient(server.URL)
+			got, err := c.NewMultisigTokenInstruction(context.Background(), multisig, tt.signers, build)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+	"strconv"
+	"strings"
+)
+
+const (
+	LamportsPerSOL uint64 = 1_000_000_000
+	SOLDecimals    uint8  = 9
+)
+
+var (
+	ErrInvalidSOLAmount  = errors.New("invalid sol amount")
+	ErrLamportsOverflow  = errors.New("lamports overflow")
+	ErrLamportsUnderflow = errors.New("lamports underflow")
+)
+
+// Lamports is an amount of native SOL in its smallest unit
+type Lamports uint64
+
+// SOL converts a whole number of SOL to lamports
+func SOL(n uint64) (Lamports, error) {
+	hi, lo := bits.Mul64(n, LamportsPerSOL)
+	if hi != 0 {
+		return 0, fmt.Errorf("%w, got: %v SOL", ErrLamportsOverflow, n)
+	}
+	return Lamports(lo), nil
+}
+
+// MustSOL is SOL but panics on error
+func MustSOL(n uint64) Lamports {
+	l, err := SOL(n)
+	if err != nil {
+		panic(err)
+	}
+	return l
+}
+
+// ParseSOL parses a SOL amount like "1.5" into lamports without going through floats.
+func ParseSOL(s string) (Lamports, error) {
+	intPart, fracPart, hasDot := strings.Cut(s, ".")
+	if intPart == "" && (!hasDot || fracPart == "") {
+		return 0, fmt.Errorf("%w, got: %q", ErrInvalidSOLAmount, s)
+	}
+	for _, c := range intPart + fracPart {
+		if c < '0' || c > '9' {
+			return 0, fmt.Errorf("%w, got: %q", ErrInvalidSOLAmount, s)
+		}
+	}
+
+	fracPart = strings.TrimRight(fracPart, "0")
+	if len(fracPart) > int(SOLDecimals) {
+		return 0, fmt.Errorf("%w, %q is more precise than a lamport", ErrInvalidSOLAmount, s)
+	}
+
+	digits := strings.TrimLeft(intPart+fracPart+strings.Repeat("0", int(SOLDecimals)-len(fracPart)), "0")
+	if digits == "" {
+		return 0, nil
+	}
+	v, err := strconv.ParseUint(digits, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w, got: %q", ErrLamportsOverflow, s)
+	}
+	return Lamports(v), nil
+}
+
+// MustParseSOL is ParseSOL but panics on error
+func MustParseSOL(s string) Lamports {
+	l, err := ParseSOL(s)
+	if err != nil {
+		panic(err)
+	}
+	return l
+}
+
+// SOL returns the amount in SOL, e.g. "1.5" for 1500000000 lamports
+func (l Lamports) SOL() string {
+	s := strconv.FormatUint(uint64(l), 10)
+	if len(s) <= int(SOLDecimals) {
+		s = strings.Repeat("0", int(SOLDecimals)-len(s)+1) + s
+	}
+	intPart, fracPart := s[:len(s)-int(SOLDecimals)], strings.TrimRight(s[len(s)-int(SOLDecimals):], "0")
+	if fracPart == "" {
+		return intPart
+	}
+	return intPart + "." + fracPart
+}
+
+// FormatSOL returns the amount with its unit, e.g. "1.5 SOL"
+func (l Lamports) FormatSOL() string {
+	return l.SOL() + " SOL"
+}
+
+func (l Lamports) String() string {
+	return strconv.FormatUint(uint64(l), 10)
+}
+
+func (l Lamports) Uint64() uint64 {
+	return uint64(l)
+}
+
+func (l Lamports) CheckedAdd(o Lamports) (Lamports, error) {
+	sum, carry := bits.Add64(uint64(l), uint64(o), 0)
+	if carry != 0 {
+		return 0, ErrLamportsOverflow
+	}
+	return Lamports(sum), nil
+}
+
+func (l Lamports) CheckedSub(o Lamports) (Lamports, error) {
+	diff, borrow := bits.Sub64(uint64(l), uint64(o), 0)
+	if borrow != 0 {
+		return 0, ErrLamportsUnderflow
+	}
+	return Lamports(diff), nil
+}
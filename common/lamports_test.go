@@ -0,0 +1,96 @@
+package common
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSOL(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    Lamports
+		wantErr error
+	}{
+		{s: "1", want: 1_000_000_000},
+		{s: "1.5", want: 1_500_000_000},
+		{s: "0.000000001", want: 1},
+		{s: ".25", want: 250_000_000},
+		{s: "0", want: 0},
+		{s: "18446744073.709551615", want: math.MaxUint64},
+		{s: "18446744073.709551616", wantErr: ErrLamportsOverflow},
+		{s: "0.0000000001", wantErr: ErrInvalidSOLAmount},
+		{s: "1,5", wantErr: ErrInvalidSOLAmount},
+		{s: "", wantErr: ErrInvalidSOLAmount},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseSOL(tt.s)
+			assert.True(t, errors.Is(err, tt.wantErr), "err: %v", err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSOL(t *testing.T) {
+	tests := []struct {
+		name    string
+		n       uint64
+		want    Lamports
+		wantErr error
+	}{
+		{n: 0, want: 0},
+		{n: 1, want: 1_000_000_000},
+		{n: math.MaxUint64 / LamportsPerSOL, want: 18_446_744_073_000_000_000},
+		{n: math.MaxUint64/LamportsPerSOL + 1, wantErr: ErrLamportsOverflow},
+		{n: math.MaxUint64, wantErr: ErrLamportsOverflow},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SOL(tt.n)
+			assert.True(t, errors.Is(err, tt.wantErr), "err: %v", err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+	assert.Panics(t, func() { MustSOL(math.MaxUint64) })
+}
+
+func TestLamports_SOL(t *testing.T) {
+	tests := []struct {
+		name string
+		l    Lamports
+		want string
+	}{
+		{l: 0, want: "0"},
+		{l: 1, want: "0.000000001"},
+		{l: 1_500_000_000, want: "1.5"},
+		{l: MustSOL(2), want: "2"},
+		{l: math.MaxUint64, want: "18446744073.709551615"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.l.SOL())
+		})
+	}
+	assert.Equal(t, "1.5 SOL", Lamports(1_500_000_000).FormatSOL())
+	assert.Equal(t, "1500000000", Lamports(1_500_000_000).String())
+}
+
+func TestLamports_Checked(t *testing.T) {
+	sum, err := MustSOL(1).CheckedAdd(1)
+	assert.Nil(t, err)
+	assert.Equal(t, Lamports(1_000_000_001), sum)
+
+	_, err = Lamports(math.MaxUint64).CheckedAdd(1)
+	assert.Equal(t, ErrLamportsOverflow, err)
+
+	diff, err := MustSOL(1).CheckedSub(1)
+	assert.Nil(t, err)
+	assert.Equal(t, Lamports(999_999_999), diff)
+
+	_, err = Lamports(0).CheckedSub(1)
+	assert.Equal(t, ErrLamportsUnderflow, err)
+}
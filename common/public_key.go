@@ -105,6 +105,8 @@ func CreateWithSeed(from PublicKey, seed string, programID PublicKey) PublicKey
 	return PublicKeyFromBytes(hash[:])
 }
 
+// FindAssociatedTokenAddress only derives addresses for mints of the token program.
+// Use associated_token_account.GetAssociatedTokenAddress for Token-2022 mints.
 func FindAssociatedTokenAddress(walletAddress, tokenMintAddress PublicKey) (PublicKey, uint8, error) {
 	seeds := [][]byte{}
 	seeds = append(seeds, walletAddress.Bytes())
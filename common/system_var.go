@@ -3,11 +3,15 @@ package common
 var (
 	SysVarPubkey                 = PublicKeyFromString("Sysvar1111111111111111111111111111111111111")
 	SysVarClockPubkey            = PublicKeyFromString("SysvarC1ock11111111111111111111111111111111")
+	SysVarEpochSchedulePubkey    = PublicKeyFromString("SysvarEpochSchedu1e111111111111111111111111")
+	SysVarEpochRewardsPubkey     = PublicKeyFromString("SysvarEpochRewards1111111111111111111111111")
+	SysVarFeesPubkey             = PublicKeyFromString("SysvarFees111111111111111111111111111111111")
 	SysVarRecentBlockhashsPubkey = PublicKeyFromString("SysvarRecentB1ockHashes11111111111111111111")
 	SysVarRentPubkey             = PublicKeyFromString("SysvarRent111111111111111111111111111111111")
 	SysVarRewardsPubkey          = PublicKeyFromString("SysvarRewards111111111111111111111111111111")
 	SysVarStakeHistoryPubkey     = PublicKeyFromString("SysvarStakeHistory1111111111111111111111111")
 	SysVarInstructionsPubkey     = PublicKeyFromString("Sysvar1nstructions1111111111111111111111111")
 	SysVarSlotHashesPubkey       = PublicKeyFromString("SysvarS1otHashes111111111111111111111111111")
+	SysVarLastRestartSlotPubkey  = PublicKeyFromString("SysvarLastRestartS1ot1111111111111111111111")
 	StakeConfigPubkey            = PublicKeyFromString("StakeConfig11111111111111111111111111111111")
 )
@@ -4,13 +4,15 @@ go 1.19
 
 require (
 	filippo.io/edwards25519 v1.0.0-rc.1
+	github.com/decred/dcrd/dcrec/secp256k1/v4 v4.0.1
 	github.com/mr-tron/base58 v1.2.0
-	github.com/near/borsh-go v0.3.2-0.20220516180422-1ff87d108454
 	github.com/stretchr/testify v1.7.0
+	golang.org/x/crypto v0.9.0
 )
 
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
+	golang.org/x/sys v0.8.0 // indirect
 	gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c // indirect
 )
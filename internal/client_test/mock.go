@@ -0,0 +1,57 @@
+package client_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type Mock struct {
+	RequestBody  string
+	ResponseBody string
+}
+
+// NewMockServer serves a fixed response for every known request body, it is for
+// helpers that make more than one rpc call. Mocks with the same request body are
+// served in order and the last one is repeated. Unknown requests fail the test.
+func NewMockServer(t *testing.T, mocks []Mock) *httptest.Server {
+	var mu sync.Mutex
+	served := make([]bool, len(mocks))
+
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		body, err := io.ReadAll(req.Body)
+		assert.Nil(t, err)
+
+		var got any
+		assert.Nil(t, json.Unmarshal(body, &got))
+
+		mu.Lock()
+		defer mu.Unlock()
+		matched := -1
+		for i, mock := range mocks {
+			var expected any
+			assert.Nil(t, json.Unmarshal([]byte(mock.RequestBody), &expected))
+			if !reflect.DeepEqual(expected, got) {
+				continue
+			}
+			matched = i
+			if !served[i] {
+				break
+			}
+		}
+		if matched < 0 {
+			t.Errorf("unexpected request: %s", body)
+			rw.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		served[matched] = true
+		_, err = rw.Write([]byte(mocks[matched].ResponseBody))
+		assert.Nil(t, err)
+	}))
+}
@@ -0,0 +1,67 @@
+// Package precompile holds the signature offsets layout shared by the ed25519 and secp256r1 precompiles
+package precompile
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/qimeila/solana-go-sdk/types"
+)
+
+const (
+	OffsetsSerializedSize = 14
+	SignatureOffsetsStart = 2
+
+	// CurrentInstructionIndex makes an offset point into the precompile instruction itself
+	CurrentInstructionIndex uint16 = math.MaxUint16
+)
+
+type SignatureOffsets struct {
+	SignatureOffset           uint16
+	SignatureInstructionIndex uint16
+	PublicKeyOffset           uint16
+	PublicKeyInstructionIndex uint16
+	MessageDataOffset         uint16
+	MessageDataSize           uint16
+	MessageInstructionIndex   uint16
+}
+
+// ParseOffsets reads n offsets which follow the count and padding bytes of data.
+// It reports false if data is too short to hold them.
+func ParseOffsets(data []byte, n int) ([]SignatureOffsets, bool) {
+	if len(data) < SignatureOffsetsStart+n*OffsetsSerializedSize {
+		return nil, false
+	}
+
+	offsets := make([]SignatureOffsets, 0, n)
+	for i := 0; i < n; i++ {
+		b := data[SignatureOffsetsStart+i*OffsetsSerializedSize:]
+		offsets = append(offsets, SignatureOffsets{
+			SignatureOffset:           binary.LittleEndian.Uint16(b[0:]),
+			SignatureInstructionIndex: binary.LittleEndian.Uint16(b[2:]),
+			PublicKeyOffset:           binary.LittleEndian.Uint16(b[4:]),
+			PublicKeyInstructionIndex: binary.LittleEndian.Uint16(b[6:]),
+			MessageDataOffset:         binary.LittleEndian.Uint16(b[8:]),
+			MessageDataSize:           binary.LittleEndian.Uint16(b[10:]),
+			MessageInstructionIndex:   binary.LittleEndian.Uint16(b[12:]),
+		})
+	}
+	return offsets, true
+}
+
+// GetDataSlice returns size bytes at offset of the instruction at instructionIndex,
+// data is the precompile instruction's own data which CurrentInstructionIndex refers to.
+// It reports false if the instruction or the range does not exist.
+func GetDataSlice(data []byte, instructions []types.Instruction, instructionIndex uint16, offset uint16, size int) ([]byte, bool) {
+	if instructionIndex != CurrentInstructionIndex {
+		if int(instructionIndex) >= len(instructions) {
+			return nil, false
+		}
+		data = instructions[instructionIndex].Data
+	}
+	start := int(offset)
+	if start+size > len(data) {
+		return nil, false
+	}
+	return data[start : start+size], true
+}
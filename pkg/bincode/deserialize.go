@@ -0,0 +1,203 @@
+package bincode
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+var (
+	ErrNotPointer      = errors.New("bincode: value must be a non-nil pointer")
+	ErrUnexpectedEOF   = errors.New("bincode: unexpected end of data")
+	ErrUnsupportedType = errors.New("bincode: unsupported type")
+	ErrInvalidBool     = errors.New("bincode: invalid bool")
+	ErrInvalidOption   = errors.New("bincode: invalid option tag")
+	ErrInvalidEnum     = errors.New("bincode: invalid enum variant")
+)
+
+// DeserializeData decodes data into v, which must be a pointer. It is the reverse of SerializeData,
+// trailing bytes are ignored since accounts are often larger than the data they hold.
+func DeserializeData(data []byte, v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return ErrNotPointer
+	}
+	d := decoder{data: data}
+	return d.decode(rv.Elem())
+}
+
+type decoder struct {
+	data   []byte
+	offset int
+}
+
+func (d *decoder) read(n uint64) ([]byte, error) {
+	if uint64(len(d.data)-d.offset) < n {
+		return nil, fmt.Errorf("%w: need %v bytes at offset %v, have %v", ErrUnexpectedEOF, n, d.offset, len(d.data)-d.offset)
+	}
+	b := d.data[d.offset : d.offset+int(n)]
+	d.offset += int(n)
+	return b, nil
+}
+
+func (d *decoder) readLength() (uint64, error) {
+	b, err := d.read(8)
+	if err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint64(b), nil
+}
+
+func (d *decoder) decode(v reflect.Value) error {
+	switch v.Kind() {
+	case reflect.Bool:
+		b, err := d.read(1)
+		if err != nil {
+			return err
+		}
+		if b[0] > 1 {
+			return fmt.Errorf("%w: %v at offset %v", ErrInvalidBool, b[0], d.offset-1)
+		}
+		v.SetBool(b[0] == 1)
+		return nil
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		b, err := d.read(uint64(v.Type().Size()))
+		if err != nil {
+			return err
+		}
+		v.SetUint(readUint(b))
+		return nil
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		b, err := d.read(uint64(v.Type().Size()))
+		if err != nil {
+			return err
+		}
+		// sign extend
+		shift := 64 - 8*len(b)
+		v.SetInt(int64(readUint(b)<<shift) >> shift)
+		return nil
+	case reflect.String:
+		l, err := d.readLength()
+		if err != nil {
+			return err
+		}
+		b, err := d.read(l)
+		if err != nil {
+			return err
+		}
+		v.SetString(string(b))
+		return nil
+	case reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			b, err := d.read(uint64(v.Len()))
+			if err != nil {
+				return err
+			}
+			setBytes(v, b)
+			return nil
+		}
+		return d.decodeElems(v)
+	case reflect.Slice:
+		l, err := d.readLength()
+		if err != nil {
+			return err
+		}
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			b, err := d.read(l)
+			if err != nil {
+				return err
+			}
+			s := reflect.MakeSlice(v.Type(), int(l), int(l))
+			setBytes(s, b)
+			v.Set(s)
+			return nil
+		}
+		// don't trust the length blindly, every element takes at least a byte. empty elements take none
+		// but a hostile length would still allocate and loop that many times, so they are bound the same way
+		if l > uint64(len(d.data)-d.offset) {
+			return fmt.Errorf("%w: vec of %v elements at offset %v", ErrUnexpectedEOF, l, d.offset-8)
+		}
+		v.Set(reflect.MakeSlice(v.Type(), int(l), int(l)))
+		return d.decodeElems(v)
+	case reflect.Ptr:
+		b, err := d.read(1)
+		if err != nil {
+			return err
+		}
+		switch b[0] {
+		case 0:
+			v.Set(reflect.Zero(v.Type()))
+			return nil
+		case 1:
+			value := reflect.New(v.Type().Elem())
+			if err := d.decode(value.Elem()); err != nil {
+				return err
+			}
+			v.Set(value)
+			return nil
+		}
+		return fmt.Errorf("%w: %v at offset %v", ErrInvalidOption, b[0], d.offset-1)
+	case reflect.Struct:
+		if isEnum(v.Type()) {
+			return d.decodeEnum(v)
+		}
+		return d.decodeStruct(v)
+	}
+	return fmt.Errorf("%w: %v", ErrUnsupportedType, v.Type())
+}
+
+func (d *decoder) decodeElems(v reflect.Value) error {
+	for i := 0; i < v.Len(); i++ {
+		if err := d.decode(v.Index(i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *decoder) decodeStruct(v reflect.Value) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		if skip(t.Field(i)) {
+			continue
+		}
+		if err := d.decode(v.Field(i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *decoder) decodeEnum(v reflect.Value) error {
+	b, err := d.read(4)
+	if err != nil {
+		return err
+	}
+	variant := uint64(binary.LittleEndian.Uint32(b))
+	if variant+1 >= uint64(v.NumField()) {
+		return fmt.Errorf("%w: %v has no variant %v at offset %v", ErrInvalidEnum, v.Type(), variant, d.offset-4)
+	}
+	v.Field(0).SetUint(variant)
+	return d.decode(v.Field(int(variant) + 1))
+}
+
+func readUint(b []byte) uint64 {
+	var u uint64
+	for i := len(b) - 1; i >= 0; i-- {
+		u = u<<8 | uint64(b[i])
+	}
+	return u
+}
+
+// setBytes copies b into an array or slice of uint8 based elements, a named element type
+// such as a C-like enum can't take reflect.Copy from []byte
+func setBytes(v reflect.Value, b []byte) {
+	if v.Type().Elem() == reflect.TypeOf(byte(0)) {
+		reflect.Copy(v, reflect.ValueOf(b))
+		return
+	}
+	for i, c := range b {
+		v.Index(i).SetUint(uint64(c))
+	}
+}
@@ -0,0 +1,202 @@
+package bincode
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/qimeila/solana-go-sdk/pkg/pointer"
+	"github.com/stretchr/testify/assert"
+)
+
+type testEnum struct {
+	Variant  uint32 `bincode:"enum"`
+	Unit     struct{}
+	Lamports uint64
+	Seed     testSeed
+}
+
+type testSeed struct {
+	Seed  string
+	Space uint64
+}
+
+type testSkip struct {
+	A     uint8
+	Cache uint64 `bincode:"skip"`
+	B     uint8
+}
+
+type testKind uint8
+
+func TestSerializeDeserializeData(t *testing.T) {
+	// values are pointers so that they can be decoded into, the pointee is serialized
+	tests := []struct {
+		name  string
+		value any
+		want  []byte
+	}{
+		{
+			name: "integers",
+			value: &struct {
+				A bool
+				B uint8
+				C uint16
+				D uint32
+				E uint64
+				F int8
+				G int16
+				H int32
+				I int64
+			}{true, 1, 2, 3, 4, -1, -2, -3, -4},
+			want: []byte{
+				1,
+				1,
+				2, 0,
+				3, 0, 0, 0,
+				4, 0, 0, 0, 0, 0, 0, 0,
+				255,
+				254, 255,
+				253, 255, 255, 255,
+				252, 255, 255, 255, 255, 255, 255, 255,
+			},
+		},
+		{
+			name:  "string",
+			value: pointer.Get("hi"),
+			want:  []byte{2, 0, 0, 0, 0, 0, 0, 0, 'h', 'i'},
+		},
+		{
+			name:  "fixed array",
+			value: &[2][2]byte{{1, 2}, {3, 4}},
+			want:  []byte{1, 2, 3, 4},
+		},
+		{
+			name:  "vec u8",
+			value: &[]byte{1, 2},
+			want:  []byte{2, 0, 0, 0, 0, 0, 0, 0, 1, 2},
+		},
+		{
+			name:  "vec struct",
+			value: &[]testSeed{{"a", 1}},
+			want:  []byte{1, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 'a', 1, 0, 0, 0, 0, 0, 0, 0},
+		},
+		{
+			name: "vec and array of a named uint8",
+			value: &struct {
+				A []testKind
+				B [2]testKind
+			}{[]testKind{1, 0}, [2]testKind{0, 1}},
+			want: []byte{2, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 1},
+		},
+		{
+			name:  "option none",
+			value: &struct{ A *uint64 }{},
+			want:  []byte{0},
+		},
+		{
+			name:  "option some",
+			value: &struct{ A *uint64 }{pointer.Get[uint64](7)},
+			want:  []byte{1, 7, 0, 0, 0, 0, 0, 0, 0},
+		},
+		{
+			name:  "enum unit variant",
+			value: &testEnum{Variant: 0},
+			want:  []byte{0, 0, 0, 0},
+		},
+		{
+			name:  "enum primitive variant",
+			value: &testEnum{Variant: 1, Lamports: 5},
+			want:  []byte{1, 0, 0, 0, 5, 0, 0, 0, 0, 0, 0, 0},
+		},
+		{
+			name:  "enum struct variant",
+			value: &testEnum{Variant: 2, Seed: testSeed{Seed: "a", Space: 2}},
+			want:  []byte{2, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 'a', 2, 0, 0, 0, 0, 0, 0, 0},
+		},
+		{
+			name:  "skip",
+			value: &testSkip{A: 1, B: 2},
+			want:  []byte{1, 2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SerializeData(reflect.ValueOf(tt.value).Elem().Interface())
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, got)
+
+			decoded := reflect.New(reflect.TypeOf(tt.value).Elem()).Interface()
+			assert.Nil(t, DeserializeData(tt.want, decoded))
+			assert.Equal(t, tt.value, decoded)
+		})
+	}
+}
+
+func TestDeserializeDataError(t *testing.T) {
+	tests := []struct {
+		name  string
+		data  []byte
+		value any
+		err   error
+	}{
+		{
+			name:  "not a pointer",
+			data:  []byte{1},
+			value: uint8(0),
+			err:   ErrNotPointer,
+		},
+		{
+			name:  "short integer",
+			data:  []byte{1, 0, 0},
+			value: new(uint32),
+			err:   ErrUnexpectedEOF,
+		},
+		{
+			name:  "short vec",
+			data:  []byte{3, 0, 0, 0, 0, 0, 0, 0, 1, 2},
+			value: new([]byte),
+			err:   ErrUnexpectedEOF,
+		},
+		{
+			name:  "huge vec",
+			data:  []byte{255, 255, 255, 255, 255, 255, 255, 255, 1},
+			value: new([]testSeed),
+			err:   ErrUnexpectedEOF,
+		},
+		{
+			name:  "huge vec of empty elements",
+			data:  []byte{255, 255, 255, 255, 255, 255, 255, 255},
+			value: new([]struct{}),
+			err:   ErrUnexpectedEOF,
+		},
+		{
+			name:  "invalid bool",
+			data:  []byte{2},
+			value: new(bool),
+			err:   ErrInvalidBool,
+		},
+		{
+			name:  "invalid option",
+			data:  []byte{2},
+			value: new(*uint64),
+			err:   ErrInvalidOption,
+		},
+		{
+			name:  "invalid enum",
+			data:  []byte{3, 0, 0, 0},
+			value: new(testEnum),
+			err:   ErrInvalidEnum,
+		},
+		{
+			name:  "unsupported type",
+			data:  []byte{1, 0, 0, 0, 0, 0, 0, 0},
+			value: new(int),
+			err:   ErrUnsupportedType,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, DeserializeData(tt.data, tt.value), tt.err)
+		})
+	}
+}
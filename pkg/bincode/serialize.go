@@ -6,6 +6,21 @@ import (
 	"reflect"
 )
 
+// A struct whose first field is a uint32 tagged `bincode:"enum"` is a rust enum, only the
+// field right after the tag's value is encoded. A field tagged `bincode:"skip"` is ignored.
+const (
+	tagEnum = "enum"
+	tagSkip = "skip"
+)
+
+func isEnum(t reflect.Type) bool {
+	return t.NumField() > 0 && t.Field(0).Type.Kind() == reflect.Uint32 && t.Field(0).Tag.Get("bincode") == tagEnum
+}
+
+func skip(field reflect.StructField) bool {
+	return field.Tag.Get("bincode") == tagSkip
+}
+
 func SerializeData(data any) ([]byte, error) {
 	return serializeData(reflect.ValueOf(data))
 }
@@ -27,6 +42,8 @@ func serializeData(v reflect.Value) ([]byte, error) {
 		return []byte{0}, nil
 	case reflect.Uint8:
 		return []byte{uint8(v.Uint())}, nil
+	case reflect.Int8:
+		return []byte{uint8(v.Int())}, nil
 	case reflect.Int16:
 		b := make([]byte, 2)
 		binary.LittleEndian.PutUint16(b, uint16(v.Int()))
@@ -52,25 +69,19 @@ func serializeData(v reflect.Value) ([]byte, error) {
 		binary.LittleEndian.PutUint64(b, v.Uint())
 		return b, nil
 	case reflect.Slice:
-		switch v.Type().Elem().Kind() {
-		case reflect.Array:
-			l := v.Len()
-			output := make([]byte, 0, 8+l*v.Type().Elem().Len())
-
-			b := make([]byte, 8)
-			binary.LittleEndian.PutUint64(b, uint64(v.Len()))
-
-			output = append(output, b...)
-			for i := 0; i < l; i++ {
-				d, err := serializeData(v.Index(i))
-				if err != nil {
-					return nil, err
-				}
-				output = append(output, d...)
+		output := make([]byte, 8, 8+v.Len())
+		binary.LittleEndian.PutUint64(output, uint64(v.Len()))
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return append(output, v.Bytes()...), nil
+		}
+		for i := 0; i < v.Len(); i++ {
+			d, err := serializeData(v.Index(i))
+			if err != nil {
+				return nil, err
 			}
-			return output, nil
+			output = append(output, d...)
 		}
-		return nil, fmt.Errorf("unsupport type: %v, elem: %v", v.Kind(), v.Elem().Kind())
+		return output, nil
 	case reflect.Array:
 		switch v.Type().Elem().Kind() {
 		case reflect.Uint8:
@@ -80,7 +91,15 @@ func serializeData(v reflect.Value) ([]byte, error) {
 			}
 			return b, nil
 		}
-		return nil, fmt.Errorf("unsupport type: %v, elem: %v", v.Kind(), v.Elem().Kind())
+		output := make([]byte, 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			d, err := serializeData(v.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			output = append(output, d...)
+		}
+		return output, nil
 	case reflect.String:
 		b := make([]byte, 8+len(v.String()))
 		binary.LittleEndian.PutUint64(b, uint64(len(v.String())))
@@ -99,8 +118,22 @@ func serializeData(v reflect.Value) ([]byte, error) {
 		copy(b[1:], d[:])
 		return b, nil
 	case reflect.Struct:
+		if isEnum(v.Type()) {
+			variant := int(v.Field(0).Uint())
+			if variant+1 >= v.NumField() {
+				return nil, fmt.Errorf("%w: %v has no variant %v", ErrInvalidEnum, v.Type(), variant)
+			}
+			d, err := serializeData(v.Field(variant + 1))
+			if err != nil {
+				return nil, err
+			}
+			return append(binary.LittleEndian.AppendUint32(nil, uint32(variant)), d...), nil
+		}
 		data := make([]byte, 0, 1024)
 		for i := 0; i < v.NumField(); i++ {
+			if skip(v.Type().Field(i)) {
+				continue
+			}
 			field := v.Field(i)
 			d, err := serializeData(field)
 			if err != nil {
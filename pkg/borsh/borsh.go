@@ -0,0 +1,82 @@
+// Package borsh implements the borsh binary format, https://borsh.io.
+//
+// Go types map to borsh types as follows:
+//
+//	bool, uintN, intN, floatN  bool, uN, iN, fN
+//	big.Int                    u128, or i128 with `borsh:"i128"`
+//	string                     String
+//	[N]T                       [T; N]
+//	[]T                        Vec<T>
+//	map[K]V                    HashMap<K, V>, keys are written in order
+//	*T                         Option<T>, or COption<T> with `borsh:"coption"`
+//	struct                     struct, fields are written in order
+//
+// A struct whose first field is an Enum tagged `borsh:"enum"` is a tagged union. The Enum
+// is the variant index and only the field right after the Enum's value is written, e.g.
+//
+//	type Payload struct {
+//		Enum   borsh.Enum `borsh:"enum"`
+//		Empty  struct{}
+//		Amount uint64
+//		Data   []byte
+//	}
+//
+// A field tagged `borsh:"skip"` is neither written nor read.
+package borsh
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+)
+
+// Enum is the variant index of a tagged union, a C-like enum is any type based on uint8
+type Enum uint8
+
+var (
+	ErrNotPointer      = errors.New("borsh: value must be a non-nil pointer")
+	ErrUnexpectedEOF   = errors.New("borsh: unexpected end of data")
+	ErrUnsupportedType = errors.New("borsh: unsupported type")
+	ErrInvalidBool     = errors.New("borsh: invalid bool")
+	ErrInvalidOption   = errors.New("borsh: invalid option tag")
+	ErrInvalidEnum     = errors.New("borsh: invalid enum variant")
+	ErrIntegerOverflow = errors.New("borsh: integer overflows 128 bits")
+	ErrUnexportedField = errors.New("borsh: unexported field")
+	ErrDuplicateMapKey = errors.New("borsh: duplicate map key")
+)
+
+const (
+	tagEnum    = "enum"
+	tagSkip    = "skip"
+	tagCOption = "coption"
+	tagI128    = "i128"
+)
+
+type fieldOptions struct {
+	skip    bool
+	enum    bool
+	coption bool
+	i128    bool
+}
+
+func parseTag(field reflect.StructField) fieldOptions {
+	var opts fieldOptions
+	for _, opt := range strings.Split(field.Tag.Get("borsh"), ",") {
+		switch opt {
+		case tagSkip:
+			opts.skip = true
+		case tagEnum:
+			opts.enum = true
+		case tagCOption:
+			opts.coption = true
+		case tagI128:
+			opts.i128 = true
+		}
+	}
+	return opts
+}
+
+// isEnum reports whether a struct is a tagged union
+func isEnum(t reflect.Type) bool {
+	return t.NumField() > 0 && t.Field(0).Type.Kind() == reflect.Uint8 && parseTag(t.Field(0)).enum
+}
@@ -0,0 +1,357 @@
+package borsh
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/qimeila/solana-go-sdk/pkg/pointer"
+	"github.com/stretchr/testify/assert"
+)
+
+type testEnum struct {
+	Enum   Enum `borsh:"enum"`
+	Unit   struct{}
+	Amount uint64
+	Data   []byte
+	Pair   testPair
+}
+
+type testPair struct {
+	A uint8
+	B int16
+}
+
+type testCOption struct {
+	Delegate *[4]byte `borsh:"coption"`
+	Amount   uint64
+}
+
+type testSkip struct {
+	A     uint8
+	Cache string `borsh:"skip"`
+	B     uint8
+}
+
+type testI128 struct {
+	U big.Int
+	I big.Int `borsh:"i128"`
+}
+
+type testKind uint8
+
+const (
+	testKindA testKind = iota
+	testKindB
+)
+
+func TestSerializeDeserialize(t *testing.T) {
+	// values are pointers so that they can be decoded into, the pointee is serialized
+	tests := []struct {
+		name  string
+		value any
+		want  []byte
+	}{
+		{
+			name:  "bool",
+			value: pointer.Get(true),
+			want:  []byte{1},
+		},
+		{
+			name: "integers",
+			value: &struct {
+				A uint8
+				B uint16
+				C uint32
+				D uint64
+				E int8
+				F int16
+				G int32
+				H int64
+			}{1, 2, 3, 4, -1, -2, -3, -4},
+			want: []byte{
+				1,
+				2, 0,
+				3, 0, 0, 0,
+				4, 0, 0, 0, 0, 0, 0, 0,
+				255,
+				254, 255,
+				253, 255, 255, 255,
+				252, 255, 255, 255, 255, 255, 255, 255,
+			},
+		},
+		{
+			name: "floats",
+			value: &struct {
+				A float32
+				B float64
+			}{1.5, -2},
+			want: []byte{0, 0, 192, 63, 0, 0, 0, 0, 0, 0, 0, 192},
+		},
+		{
+			name:  "string",
+			value: pointer.Get("hi"),
+			want:  []byte{2, 0, 0, 0, 'h', 'i'},
+		},
+		{
+			name:  "fixed array",
+			value: &[3]byte{1, 2, 3},
+			want:  []byte{1, 2, 3},
+		},
+		{
+			name:  "fixed array of u16",
+			value: &[2]uint16{1, 256},
+			want:  []byte{1, 0, 0, 1},
+		},
+		{
+			name:  "vec u8",
+			value: &[]byte{1, 2},
+			want:  []byte{2, 0, 0, 0, 1, 2},
+		},
+		{
+			name:  "vec struct",
+			value: &[]testPair{{1, 2}, {3, -1}},
+			want:  []byte{2, 0, 0, 0, 1, 2, 0, 3, 255, 255},
+		},
+		{
+			name:  "map",
+			value: &map[string]uint8{"b": 2, "a": 1},
+			want:  []byte{2, 0, 0, 0, 1, 0, 0, 0, 'a', 1, 1, 0, 0, 0, 'b', 2},
+		},
+		{
+			name:  "map with integer keys",
+			value: &map[uint16]bool{256: true, 1: false},
+			want:  []byte{2, 0, 0, 0, 1, 0, 0, 0, 1, 1},
+		},
+		{
+			name:  "map with multi-byte integer keys",
+			value: &map[uint32]uint8{0x01000000: 1, 2: 2},
+			want:  []byte{2, 0, 0, 0, 2, 0, 0, 0, 2, 0, 0, 0, 1, 1},
+		},
+		{
+			name:  "map with negative i8 keys",
+			value: &map[int8]bool{1: false, -1: true},
+			want:  []byte{2, 0, 0, 0, 255, 1, 1, 0},
+		},
+		{
+			name:  "map with negative i16 keys",
+			value: &map[int16]uint8{256: 4, 1: 3, -1: 2, -256: 1},
+			want:  []byte{4, 0, 0, 0, 0, 255, 1, 255, 255, 2, 1, 0, 3, 0, 1, 4},
+		},
+		{
+			name:  "map with negative i64 keys",
+			value: &map[int64]uint8{1: 2, -2: 1},
+			want:  []byte{2, 0, 0, 0, 254, 255, 255, 255, 255, 255, 255, 255, 1, 1, 0, 0, 0, 0, 0, 0, 0, 2},
+		},
+		{
+			name:  "option none",
+			value: &struct{ A *uint32 }{},
+			want:  []byte{0},
+		},
+		{
+			name:  "option some",
+			value: &struct{ A *uint32 }{pointer.Get[uint32](7)},
+			want:  []byte{1, 7, 0, 0, 0},
+		},
+		{
+			name:  "coption none",
+			value: &testCOption{Amount: 1},
+			want:  []byte{0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0},
+		},
+		{
+			name:  "coption some",
+			value: &testCOption{Delegate: &[4]byte{1, 2, 3, 4}, Amount: 1},
+			want:  []byte{1, 0, 0, 0, 1, 2, 3, 4, 1, 0, 0, 0, 0, 0, 0, 0},
+		},
+		{
+			name:  "c-like enum",
+			value: pointer.Get(testKindB),
+			want:  []byte{1},
+		},
+		{
+			name: "c-like enum vec and array",
+			value: &struct {
+				A []testKind
+				B [2]testKind
+			}{[]testKind{testKindB, testKindA}, [2]testKind{testKindA, testKindB}},
+			want: []byte{2, 0, 0, 0, 1, 0, 0, 1},
+		},
+		{
+			name:  "enum unit variant",
+			value: &testEnum{Enum: 0},
+			want:  []byte{0},
+		},
+		{
+			name:  "enum primitive variant",
+			value: &testEnum{Enum: 1, Amount: 5},
+			want:  []byte{1, 5, 0, 0, 0, 0, 0, 0, 0},
+		},
+		{
+			name:  "enum vec variant",
+			value: &testEnum{Enum: 2, Data: []byte{9}},
+			want:  []byte{2, 1, 0, 0, 0, 9},
+		},
+		{
+			name:  "enum struct variant",
+			value: &testEnum{Enum: 3, Pair: testPair{A: 1, B: 2}},
+			want:  []byte{3, 1, 2, 0},
+		},
+		{
+			name:  "option enum",
+			value: &struct{ E *testEnum }{&testEnum{Enum: 1, Amount: 1}},
+			want:  []byte{1, 1, 1, 0, 0, 0, 0, 0, 0, 0},
+		},
+		{
+			name:  "skip",
+			value: &testSkip{A: 1, B: 2},
+			want:  []byte{1, 2},
+		},
+		{
+			name: "u128 and i128",
+			value: &testI128{
+				U: *new(big.Int).Lsh(big.NewInt(1), 64),
+				I: *big.NewInt(-2),
+			},
+			want: []byte{
+				0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0,
+				254, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Serialize(reflect.ValueOf(tt.value).Elem().Interface())
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, got)
+
+			decoded := reflectNew(tt.value)
+			assert.Nil(t, Deserialize(tt.want, decoded))
+			assert.Equal(t, tt.value, decoded)
+		})
+	}
+}
+
+func TestSerializeError(t *testing.T) {
+	tests := []struct {
+		name  string
+		value any
+		err   error
+	}{
+		{
+			name:  "int",
+			value: 1,
+			err:   ErrUnsupportedType,
+		},
+		{
+			name:  "unexported field",
+			value: struct{ a uint8 }{},
+			err:   ErrUnexportedField,
+		},
+		{
+			name:  "enum out of range",
+			value: testEnum{Enum: 4},
+			err:   ErrInvalidEnum,
+		},
+		{
+			name:  "negative u128",
+			value: testI128{U: *big.NewInt(-1)},
+			err:   ErrIntegerOverflow,
+		},
+		{
+			name:  "i128 overflow",
+			value: testI128{I: *new(big.Int).Lsh(big.NewInt(1), 127)},
+			err:   ErrIntegerOverflow,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Serialize(tt.value)
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestDeserializeError(t *testing.T) {
+	tests := []struct {
+		name  string
+		data  []byte
+		value any
+		err   error
+	}{
+		{
+			name:  "not a pointer",
+			data:  []byte{1},
+			value: uint8(0),
+			err:   ErrNotPointer,
+		},
+		{
+			name:  "short integer",
+			data:  []byte{1, 0, 0},
+			value: new(uint32),
+			err:   ErrUnexpectedEOF,
+		},
+		{
+			name:  "short string",
+			data:  []byte{3, 0, 0, 0, 'h', 'i'},
+			value: new(string),
+			err:   ErrUnexpectedEOF,
+		},
+		{
+			name:  "huge vec",
+			data:  []byte{255, 255, 255, 255, 1},
+			value: new([]testPair),
+			err:   ErrUnexpectedEOF,
+		},
+		{
+			name:  "huge map",
+			data:  []byte{255, 255, 255, 255, 1},
+			value: new(map[uint8]uint8),
+			err:   ErrUnexpectedEOF,
+		},
+		{
+			name:  "duplicate map key",
+			data:  []byte{2, 0, 0, 0, 1, 5, 1, 6},
+			value: new(map[uint8]uint8),
+			err:   ErrDuplicateMapKey,
+		},
+		{
+			name:  "invalid bool",
+			data:  []byte{2},
+			value: new(bool),
+			err:   ErrInvalidBool,
+		},
+		{
+			name:  "invalid option",
+			data:  []byte{2, 0, 0, 0, 0},
+			value: new(*uint32),
+			err:   ErrInvalidOption,
+		},
+		{
+			name:  "invalid coption",
+			data:  []byte{2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+			value: new(testCOption),
+			err:   ErrInvalidOption,
+		},
+		{
+			name:  "invalid enum",
+			data:  []byte{4},
+			value: new(testEnum),
+			err:   ErrInvalidEnum,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, Deserialize(tt.data, tt.value), tt.err)
+		})
+	}
+}
+
+func TestDeserializeIgnoresTrailingBytes(t *testing.T) {
+	var v testPair
+	assert.Nil(t, Deserialize([]byte{1, 2, 0, 9, 9}, &v))
+	assert.Equal(t, testPair{A: 1, B: 2}, v)
+}
+
+func reflectNew(v any) any {
+	return reflect.New(reflect.TypeOf(v).Elem()).Interface()
+}
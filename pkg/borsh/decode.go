@@ -0,0 +1,285 @@
+package borsh
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+)
+
+// Deserialize decodes data into v, which must be a pointer. Trailing bytes are ignored since
+// accounts are often larger than the data they hold.
+func Deserialize(data []byte, v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return ErrNotPointer
+	}
+	d := decoder{data: data}
+	return d.decode(rv.Elem(), fieldOptions{})
+}
+
+type decoder struct {
+	data   []byte
+	offset int
+}
+
+func (d *decoder) read(n int) ([]byte, error) {
+	if n < 0 || len(d.data)-d.offset < n {
+		return nil, fmt.Errorf("%w: need %v bytes at offset %v, have %v", ErrUnexpectedEOF, n, d.offset, len(d.data)-d.offset)
+	}
+	b := d.data[d.offset : d.offset+n]
+	d.offset += n
+	return b, nil
+}
+
+func (d *decoder) readUint32() (uint32, error) {
+	b, err := d.read(4)
+	if err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint32(b), nil
+}
+
+func (d *decoder) decode(v reflect.Value, opts fieldOptions) error {
+	switch v.Kind() {
+	case reflect.Bool:
+		b, err := d.read(1)
+		if err != nil {
+			return err
+		}
+		switch b[0] {
+		case 0:
+			v.SetBool(false)
+		case 1:
+			v.SetBool(true)
+		default:
+			return fmt.Errorf("%w: %v at offset %v", ErrInvalidBool, b[0], d.offset-1)
+		}
+		return nil
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		b, err := d.read(int(v.Type().Size()))
+		if err != nil {
+			return err
+		}
+		v.SetUint(readUint(b))
+		return nil
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		b, err := d.read(int(v.Type().Size()))
+		if err != nil {
+			return err
+		}
+		// sign extend
+		shift := 64 - 8*len(b)
+		v.SetInt(int64(readUint(b)<<shift) >> shift)
+		return nil
+	case reflect.Float32:
+		b, err := d.read(4)
+		if err != nil {
+			return err
+		}
+		v.SetFloat(float64(math.Float32frombits(binary.LittleEndian.Uint32(b))))
+		return nil
+	case reflect.Float64:
+		b, err := d.read(8)
+		if err != nil {
+			return err
+		}
+		v.SetFloat(math.Float64frombits(binary.LittleEndian.Uint64(b)))
+		return nil
+	case reflect.String:
+		l, err := d.readUint32()
+		if err != nil {
+			return err
+		}
+		b, err := d.read(int(l))
+		if err != nil {
+			return err
+		}
+		v.SetString(string(b))
+		return nil
+	case reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			b, err := d.read(v.Len())
+			if err != nil {
+				return err
+			}
+			setBytes(v, b)
+			return nil
+		}
+		return d.decodeElems(v)
+	case reflect.Slice:
+		l, err := d.readUint32()
+		if err != nil {
+			return err
+		}
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			b, err := d.read(int(l))
+			if err != nil {
+				return err
+			}
+			s := reflect.MakeSlice(v.Type(), int(l), int(l))
+			setBytes(s, b)
+			v.Set(s)
+			return nil
+		}
+		// every element takes at least a byte unless it is empty, don't trust the length blindly
+		if int(l) > len(d.data)-d.offset && v.Type().Elem().Size() > 0 {
+			return fmt.Errorf("%w: vec of %v elements at offset %v", ErrUnexpectedEOF, l, d.offset-4)
+		}
+		v.Set(reflect.MakeSlice(v.Type(), int(l), int(l)))
+		return d.decodeElems(v)
+	case reflect.Map:
+		l, err := d.readUint32()
+		if err != nil {
+			return err
+		}
+		// as for vecs, every entry takes at least a byte unless both key and value are empty
+		if int(l) > len(d.data)-d.offset && v.Type().Key().Size()+v.Type().Elem().Size() > 0 {
+			return fmt.Errorf("%w: map of %v entries at offset %v", ErrUnexpectedEOF, l, d.offset-4)
+		}
+		m := reflect.MakeMapWithSize(v.Type(), 0)
+		for i := 0; i < int(l); i++ {
+			keyOffset := d.offset
+			key := reflect.New(v.Type().Key()).Elem()
+			if err := d.decode(key, fieldOptions{}); err != nil {
+				return err
+			}
+			if m.MapIndex(key).IsValid() {
+				return fmt.Errorf("%w: at offset %v", ErrDuplicateMapKey, keyOffset)
+			}
+			value := reflect.New(v.Type().Elem()).Elem()
+			if err := d.decode(value, fieldOptions{}); err != nil {
+				return err
+			}
+			m.SetMapIndex(key, value)
+		}
+		v.Set(m)
+		return nil
+	case reflect.Ptr:
+		if opts.coption {
+			tag, err := d.readUint32()
+			if err != nil {
+				return err
+			}
+			if tag > 1 {
+				return fmt.Errorf("%w: %v at offset %v", ErrInvalidOption, tag, d.offset-4)
+			}
+			// the value is always present
+			value := reflect.New(v.Type().Elem())
+			if err := d.decode(value.Elem(), fieldOptions{}); err != nil {
+				return err
+			}
+			if tag == 1 {
+				v.Set(value)
+			} else {
+				v.Set(reflect.Zero(v.Type()))
+			}
+			return nil
+		}
+		b, err := d.read(1)
+		if err != nil {
+			return err
+		}
+		switch b[0] {
+		case 0:
+			v.Set(reflect.Zero(v.Type()))
+			return nil
+		case 1:
+			value := reflect.New(v.Type().Elem())
+			if err := d.decode(value.Elem(), opts); err != nil {
+				return err
+			}
+			v.Set(value)
+			return nil
+		}
+		return fmt.Errorf("%w: %v at offset %v", ErrInvalidOption, b[0], d.offset-1)
+	case reflect.Struct:
+		if v.Type() == bigIntType {
+			b, err := d.read(16)
+			if err != nil {
+				return err
+			}
+			n := decode128(b, opts.i128)
+			v.Set(reflect.ValueOf(*n))
+			return nil
+		}
+		if isEnum(v.Type()) {
+			return d.decodeEnum(v)
+		}
+		return d.decodeStruct(v)
+	}
+	return fmt.Errorf("%w: %v", ErrUnsupportedType, v.Type())
+}
+
+func (d *decoder) decodeElems(v reflect.Value) error {
+	for i := 0; i < v.Len(); i++ {
+		if err := d.decode(v.Index(i), fieldOptions{}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *decoder) decodeStruct(v reflect.Value) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		opts := parseTag(field)
+		if opts.skip {
+			continue
+		}
+		if !field.IsExported() {
+			return fmt.Errorf("%w: %v.%v", ErrUnexportedField, t, field.Name)
+		}
+		if err := d.decode(v.Field(i), opts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *decoder) decodeEnum(v reflect.Value) error {
+	b, err := d.read(1)
+	if err != nil {
+		return err
+	}
+	variant := int(b[0])
+	if variant+1 >= v.NumField() {
+		return fmt.Errorf("%w: %v has no variant %v at offset %v", ErrInvalidEnum, v.Type(), variant, d.offset-1)
+	}
+	v.Field(0).SetUint(uint64(variant))
+	return d.decode(v.Field(variant+1), parseTag(v.Type().Field(variant+1)))
+}
+
+func readUint(b []byte) uint64 {
+	var u uint64
+	for i := len(b) - 1; i >= 0; i-- {
+		u = u<<8 | uint64(b[i])
+	}
+	return u
+}
+
+func decode128(b []byte, signed bool) *big.Int {
+	var d [16]byte
+	for i := 0; i < 16; i++ {
+		d[i] = b[15-i]
+	}
+	n := new(big.Int).SetBytes(d[:])
+	if signed && d[0]&0x80 != 0 {
+		n.Sub(n, twoTo128)
+	}
+	return n
+}
+
+// setBytes copies b into an array or slice of uint8 based elements, a named element type
+// such as a C-like enum can't take reflect.Copy from []byte
+func setBytes(v reflect.Value, b []byte) {
+	if v.Type().Elem() == reflect.TypeOf(byte(0)) {
+		reflect.Copy(v, reflect.ValueOf(b))
+		return
+	}
+	for i, c := range b {
+		v.Index(i).SetUint(uint64(c))
+	}
+}
@@ -0,0 +1,212 @@
+package borsh
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"sort"
+)
+
+var bigIntType = reflect.TypeOf(big.Int{})
+
+// Serialize encodes v
+func Serialize(v any) ([]byte, error) {
+	return serialize(make([]byte, 0, 64), reflect.ValueOf(v), fieldOptions{})
+}
+
+// MustSerialize is like Serialize but panics on error
+func MustSerialize(v any) []byte {
+	b, err := Serialize(v)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+func serialize(b []byte, v reflect.Value, opts fieldOptions) ([]byte, error) {
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			return append(b, 1), nil
+		}
+		return append(b, 0), nil
+	case reflect.Uint8:
+		return append(b, uint8(v.Uint())), nil
+	case reflect.Uint16:
+		return binary.LittleEndian.AppendUint16(b, uint16(v.Uint())), nil
+	case reflect.Uint32:
+		return binary.LittleEndian.AppendUint32(b, uint32(v.Uint())), nil
+	case reflect.Uint64:
+		return binary.LittleEndian.AppendUint64(b, v.Uint()), nil
+	case reflect.Int8:
+		return append(b, uint8(v.Int())), nil
+	case reflect.Int16:
+		return binary.LittleEndian.AppendUint16(b, uint16(v.Int())), nil
+	case reflect.Int32:
+		return binary.LittleEndian.AppendUint32(b, uint32(v.Int())), nil
+	case reflect.Int64:
+		return binary.LittleEndian.AppendUint64(b, uint64(v.Int())), nil
+	case reflect.Float32:
+		return binary.LittleEndian.AppendUint32(b, math.Float32bits(float32(v.Float()))), nil
+	case reflect.Float64:
+		return binary.LittleEndian.AppendUint64(b, math.Float64bits(v.Float())), nil
+	case reflect.String:
+		b = binary.LittleEndian.AppendUint32(b, uint32(v.Len()))
+		return append(b, v.String()...), nil
+	case reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			for i := 0; i < v.Len(); i++ {
+				b = append(b, uint8(v.Index(i).Uint()))
+			}
+			return b, nil
+		}
+		return serializeElems(b, v)
+	case reflect.Slice:
+		b = binary.LittleEndian.AppendUint32(b, uint32(v.Len()))
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return append(b, v.Bytes()...), nil
+		}
+		return serializeElems(b, v)
+	case reflect.Map:
+		return serializeMap(b, v)
+	case reflect.Ptr:
+		if opts.coption {
+			if v.IsNil() {
+				b = binary.LittleEndian.AppendUint32(b, 0)
+				return serialize(b, reflect.Zero(v.Type().Elem()), fieldOptions{})
+			}
+			b = binary.LittleEndian.AppendUint32(b, 1)
+			return serialize(b, v.Elem(), fieldOptions{})
+		}
+		if v.IsNil() {
+			return append(b, 0), nil
+		}
+		return serialize(append(b, 1), v.Elem(), opts)
+	case reflect.Struct:
+		if v.Type() == bigIntType {
+			n := v.Interface().(big.Int)
+			return serialize128(b, &n, opts.i128)
+		}
+		if isEnum(v.Type()) {
+			return serializeEnum(b, v)
+		}
+		return serializeStruct(b, v)
+	}
+	return nil, fmt.Errorf("%w: %v", ErrUnsupportedType, v.Type())
+}
+
+func serializeElems(b []byte, v reflect.Value) ([]byte, error) {
+	var err error
+	for i := 0; i < v.Len(); i++ {
+		b, err = serialize(b, v.Index(i), fieldOptions{})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// serializeMap writes entries ordered by their keys, it is the order of rust's BTreeMap for
+// integers, strings and byte arrays, and keeps the output deterministic otherwise
+func serializeMap(b []byte, v reflect.Value) ([]byte, error) {
+	type entry struct {
+		key     reflect.Value
+		encoded []byte
+		value   reflect.Value
+	}
+	entries := make([]entry, 0, v.Len())
+	iter := v.MapRange()
+	for iter.Next() {
+		encoded, err := serialize(nil, iter.Key(), fieldOptions{})
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry{key: iter.Key(), encoded: encoded, value: iter.Value()})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return lessKey(entries[i].key, entries[j].key, entries[i].encoded, entries[j].encoded)
+	})
+
+	b = binary.LittleEndian.AppendUint32(b, uint32(len(entries)))
+	var err error
+	for _, e := range entries {
+		b = append(b, e.encoded...)
+		b, err = serialize(b, e.value, fieldOptions{})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// lessKey orders integers and strings by value and anything else by its encoding
+func lessKey(a, b reflect.Value, encodedA, encodedB []byte) bool {
+	switch a.Kind() {
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return a.Int() < b.Int()
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return a.Uint() < b.Uint()
+	case reflect.String:
+		return a.String() < b.String()
+	}
+	return string(encodedA) < string(encodedB)
+}
+
+func serializeStruct(b []byte, v reflect.Value) ([]byte, error) {
+	t := v.Type()
+	var err error
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		opts := parseTag(field)
+		if opts.skip {
+			continue
+		}
+		if !field.IsExported() {
+			return nil, fmt.Errorf("%w: %v.%v", ErrUnexportedField, t, field.Name)
+		}
+		b, err = serialize(b, v.Field(i), opts)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+func serializeEnum(b []byte, v reflect.Value) ([]byte, error) {
+	variant := int(v.Field(0).Uint())
+	if variant+1 >= v.NumField() {
+		return nil, fmt.Errorf("%w: %v has no variant %v", ErrInvalidEnum, v.Type(), variant)
+	}
+	b = append(b, uint8(variant))
+	field := v.Type().Field(variant + 1)
+	return serialize(b, v.Field(variant+1), parseTag(field))
+}
+
+func serialize128(b []byte, n *big.Int, signed bool) ([]byte, error) {
+	u := new(big.Int).Set(n)
+	if signed {
+		if n.Cmp(minI128) < 0 || n.Cmp(maxI128) > 0 {
+			return nil, fmt.Errorf("%w: %v", ErrIntegerOverflow, n)
+		}
+		if n.Sign() < 0 {
+			u.Add(u, twoTo128)
+		}
+	} else if n.Sign() < 0 || n.BitLen() > 128 {
+		return nil, fmt.Errorf("%w: %v", ErrIntegerOverflow, n)
+	}
+
+	var d [16]byte
+	u.FillBytes(d[:])
+	for i := 15; i >= 0; i-- {
+		b = append(b, d[i])
+	}
+	return b, nil
+}
+
+var (
+	twoTo128 = new(big.Int).Lsh(big.NewInt(1), 128)
+	maxI128  = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 127), big.NewInt(1))
+	minI128  = new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 127))
+)
@@ -12,7 +12,7 @@ func GetUint64(curr *int, data []byte) (uint64, error) {
 	if data == nil {
 		return 0, fmt.Errorf("data is nil")
 	}
-	if len(data[*curr:]) < 8 {
+	if *curr < 0 || *curr > len(data) || len(data[*curr:]) < 8 {
 		return 0, fmt.Errorf("insufficient data length")
 	}
 
@@ -31,7 +31,7 @@ func GetBytes32(curr *int, data []byte) ([32]byte, error) {
 	if data == nil {
 		return v, fmt.Errorf("data is nil")
 	}
-	if len(data[*curr:]) < 32 {
+	if *curr < 0 || *curr > len(data) || len(data[*curr:]) < 32 {
 		return v, fmt.Errorf("insufficient data length")
 	}
 
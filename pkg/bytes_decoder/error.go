@@ -0,0 +1,30 @@
+package bytes_decoder
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrUnexpectedEOF     = errors.New("unexpected end of data")
+	ErrInvalidBool       = errors.New("invalid bool")
+	ErrInvalidOption     = errors.New("invalid option tag")
+	ErrInvalidCompactU16 = errors.New("invalid compact-u16")
+	ErrInvalidLength     = errors.New("invalid length")
+	ErrUint128Overflow   = errors.New("value overflows u128")
+)
+
+// Error is returned by Reader, it tells which field failed and where
+type Error struct {
+	Field  string
+	Offset int
+	Err    error
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("failed to read %v at offset %v: %v", e.Field, e.Offset, e.Err)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
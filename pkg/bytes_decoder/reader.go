@@ -0,0 +1,259 @@
+package bytes_decoder
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/big"
+
+	"github.com/qimeila/solana-go-sdk/common"
+)
+
+// Reader reads little endian values from account or instruction data. Every read takes the
+// name of the field so that errors point at what was being decoded.
+type Reader struct {
+	data   []byte
+	offset int
+}
+
+func NewReader(data []byte) *Reader {
+	return &Reader{data: data}
+}
+
+// Offset is the position of the next read
+func (r *Reader) Offset() int {
+	return r.offset
+}
+
+// Remaining is the number of unread bytes
+func (r *Reader) Remaining() int {
+	return len(r.data) - r.offset
+}
+
+func (r *Reader) fail(field string, err error) error {
+	return &Error{Field: field, Offset: r.offset, Err: err}
+}
+
+func (r *Reader) read(n int, field string) ([]byte, error) {
+	if n < 0 {
+		return nil, r.fail(field, fmt.Errorf("%w: %v", ErrInvalidLength, n))
+	}
+	if r.Remaining() < n {
+		return nil, r.fail(field, fmt.Errorf("%w: need %v bytes, have %v", ErrUnexpectedEOF, n, r.Remaining()))
+	}
+	b := r.data[r.offset : r.offset+n]
+	r.offset += n
+	return b, nil
+}
+
+// Skip moves past n bytes, e.g. padding or reserved space
+func (r *Reader) Skip(n int, field string) error {
+	_, err := r.read(n, field)
+	return err
+}
+
+// ReadBytes returns the next n bytes, the slice shares memory with the data
+func (r *Reader) ReadBytes(n int, field string) ([]byte, error) {
+	return r.read(n, field)
+}
+
+func (r *Reader) ReadUint8(field string) (uint8, error) {
+	b, err := r.read(1, field)
+	if err != nil {
+		return 0, err
+	}
+	return b[0], nil
+}
+
+func (r *Reader) ReadUint16(field string) (uint16, error) {
+	b, err := r.read(2, field)
+	if err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint16(b), nil
+}
+
+func (r *Reader) ReadUint32(field string) (uint32, error) {
+	b, err := r.read(4, field)
+	if err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint32(b), nil
+}
+
+func (r *Reader) ReadUint64(field string) (uint64, error) {
+	b, err := r.read(8, field)
+	if err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint64(b), nil
+}
+
+func (r *Reader) ReadUint128(field string) (*big.Int, error) {
+	b, err := r.read(16, field)
+	if err != nil {
+		return nil, err
+	}
+	// u128 is little endian, big.Int wants big endian
+	be := make([]byte, 16)
+	for i := 0; i < 16; i++ {
+		be[i] = b[15-i]
+	}
+	return new(big.Int).SetBytes(be), nil
+}
+
+func (r *Reader) ReadInt8(field string) (int8, error) {
+	v, err := r.ReadUint8(field)
+	return int8(v), err
+}
+
+func (r *Reader) ReadInt16(field string) (int16, error) {
+	v, err := r.ReadUint16(field)
+	return int16(v), err
+}
+
+func (r *Reader) ReadInt32(field string) (int32, error) {
+	v, err := r.ReadUint32(field)
+	return int32(v), err
+}
+
+func (r *Reader) ReadInt64(field string) (int64, error) {
+	v, err := r.ReadUint64(field)
+	return int64(v), err
+}
+
+func (r *Reader) ReadBool(field string) (bool, error) {
+	offset := r.offset
+	v, err := r.ReadUint8(field)
+	if err != nil {
+		return false, err
+	}
+	if v > 1 {
+		return false, &Error{Field: field, Offset: offset, Err: fmt.Errorf("%w: %v", ErrInvalidBool, v)}
+	}
+	return v == 1, nil
+}
+
+func (r *Reader) ReadPublicKey(field string) (common.PublicKey, error) {
+	b, err := r.read(common.PublicKeyLength, field)
+	if err != nil {
+		return common.PublicKey{}, err
+	}
+	return common.PublicKeyFromBytes(b), nil
+}
+
+// ReadOption reads the 1 byte tag of a borsh or bincode Option, the value follows only if it is true
+func (r *Reader) ReadOption(field string) (bool, error) {
+	offset := r.offset
+	tag, err := r.ReadUint8(field)
+	if err != nil {
+		return false, err
+	}
+	if tag > 1 {
+		return false, &Error{Field: field, Offset: offset, Err: fmt.Errorf("%w: %v", ErrInvalidOption, tag)}
+	}
+	return tag == 1, nil
+}
+
+// ReadCOption reads the 4 bytes tag of a COption, the value follows even if it is false
+func (r *Reader) ReadCOption(field string) (bool, error) {
+	offset := r.offset
+	tag, err := r.ReadUint32(field)
+	if err != nil {
+		return false, err
+	}
+	if tag > 1 {
+		return false, &Error{Field: field, Offset: offset, Err: fmt.Errorf("%w: %v", ErrInvalidOption, tag)}
+	}
+	return tag == 1, nil
+}
+
+// ReadCOptionPublicKey reads a COption<Pubkey>, it is how the token program stores authorities
+func (r *Reader) ReadCOptionPublicKey(field string) (*common.PublicKey, error) {
+	return ReadCOption(r, field, (*Reader).ReadPublicKey)
+}
+
+// ReadCompactU16 reads a shortvec length as used in transactions
+func (r *Reader) ReadCompactU16(field string) (uint16, error) {
+	offset := r.offset
+	var v uint32
+	for i := 0; i < 3; i++ {
+		b, err := r.ReadUint8(field)
+		if err != nil {
+			return 0, err
+		}
+		// the third byte can only hold the 2 highest bits, and a zero continuation is an alias of a shorter encoding
+		if (i == 2 && b > 0x03) || (i > 0 && b == 0) {
+			return 0, &Error{Field: field, Offset: offset, Err: ErrInvalidCompactU16}
+		}
+		v |= uint32(b&0x7f) << (7 * i)
+		if b&0x80 == 0 {
+			return uint16(v), nil
+		}
+	}
+	return 0, &Error{Field: field, Offset: offset, Err: ErrInvalidCompactU16}
+}
+
+// ReadString reads a borsh string, a u32 length followed by utf-8 bytes
+func (r *Reader) ReadString(field string) (string, error) {
+	b, err := r.ReadVecBytes(field)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// ReadVecBytes reads a borsh Vec<u8>, the slice shares memory with the data
+func (r *Reader) ReadVecBytes(field string) ([]byte, error) {
+	l, err := r.ReadUint32(field)
+	if err != nil {
+		return nil, err
+	}
+	return r.read(int(l), field)
+}
+
+// ReadVec reads a borsh Vec<T> with f reading each element
+func ReadVec[T any](r *Reader, field string, f func(r *Reader, field string) (T, error)) ([]T, error) {
+	l, err := r.ReadUint32(field)
+	if err != nil {
+		return nil, err
+	}
+	// every element takes at least a byte, don't trust the length blindly
+	if int64(l) > int64(r.Remaining()) {
+		return nil, r.fail(field, fmt.Errorf("%w: vec of %v elements, have %v bytes", ErrUnexpectedEOF, l, r.Remaining()))
+	}
+	v := make([]T, 0, l)
+	for i := 0; i < int(l); i++ {
+		e, err := f(r, fmt.Sprintf("%v[%v]", field, i))
+		if err != nil {
+			return nil, err
+		}
+		v = append(v, e)
+	}
+	return v, nil
+}
+
+// ReadOption reads an Option<T>, it is nil for None
+func ReadOption[T any](r *Reader, field string, f func(r *Reader, field string) (T, error)) (*T, error) {
+	some, err := r.ReadOption(field)
+	if err != nil || !some {
+		return nil, err
+	}
+	v, err := f(r, field)
+	if err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// ReadCOption reads a COption<T>, it is nil for None but the value is consumed either way
+func ReadCOption[T any](r *Reader, field string, f func(r *Reader, field string) (T, error)) (*T, error) {
+	some, err := r.ReadCOption(field)
+	if err != nil {
+		return nil, err
+	}
+	v, err := f(r, field)
+	if err != nil || !some {
+		return nil, err
+	}
+	return &v, nil
+}
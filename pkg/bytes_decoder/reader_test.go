@@ -0,0 +1,278 @@
+package bytes_decoder
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/qimeila/solana-go-sdk/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReaderWriter(t *testing.T) {
+	pubkey := common.PublicKeyFromString("6xTZhtNA8aaipc2hHFP616gFvDcvWmYMGsDFHwrsF3m1")
+	u128, _ := new(big.Int).SetString("340282366920938463463374607431768211455", 10)
+
+	w := NewWriter()
+	w.WriteUint8(1)
+	w.WriteUint16(2)
+	w.WriteUint32(3)
+	w.WriteUint64(4)
+	assert.Nil(t, w.WriteUint128(u128))
+	w.WriteInt8(-1)
+	w.WriteInt16(-2)
+	w.WriteInt32(-3)
+	w.WriteInt64(-4)
+	w.WriteBool(true)
+	w.WritePublicKey(pubkey)
+	w.WriteOption(false)
+	w.WriteOption(true)
+	w.WriteUint64(5)
+	w.WriteCOptionPublicKey(nil)
+	w.WriteCOptionPublicKey(&pubkey)
+	w.WriteCompactU16(0x3fff)
+	w.WriteString("hi")
+	w.WriteVecBytes([]byte{1, 2})
+	WriteVec(w, []uint16{7, 8}, (*Writer).WriteUint16)
+	w.Skip(3)
+
+	r := NewReader(w.Bytes())
+	u8, err := r.ReadUint8("u8")
+	assert.Nil(t, err)
+	assert.Equal(t, uint8(1), u8)
+	u16, err := r.ReadUint16("u16")
+	assert.Nil(t, err)
+	assert.Equal(t, uint16(2), u16)
+	u32, err := r.ReadUint32("u32")
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(3), u32)
+	u64, err := r.ReadUint64("u64")
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(4), u64)
+	gotU128, err := r.ReadUint128("u128")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, u128.Cmp(gotU128))
+	i8, err := r.ReadInt8("i8")
+	assert.Nil(t, err)
+	assert.Equal(t, int8(-1), i8)
+	i16, err := r.ReadInt16("i16")
+	assert.Nil(t, err)
+	assert.Equal(t, int16(-2), i16)
+	i32, err := r.ReadInt32("i32")
+	assert.Nil(t, err)
+	assert.Equal(t, int32(-3), i32)
+	i64, err := r.ReadInt64("i64")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(-4), i64)
+	b, err := r.ReadBool("bool")
+	assert.Nil(t, err)
+	assert.True(t, b)
+	gotPubkey, err := r.ReadPublicKey("pubkey")
+	assert.Nil(t, err)
+	assert.Equal(t, pubkey, gotPubkey)
+	none, err := ReadOption(r, "none", (*Reader).ReadUint64)
+	assert.Nil(t, err)
+	assert.Nil(t, none)
+	some, err := ReadOption(r, "some", (*Reader).ReadUint64)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(5), *some)
+	noneKey, err := r.ReadCOptionPublicKey("none key")
+	assert.Nil(t, err)
+	assert.Nil(t, noneKey)
+	someKey, err := r.ReadCOptionPublicKey("some key")
+	assert.Nil(t, err)
+	assert.Equal(t, pubkey, *someKey)
+	l, err := r.ReadCompactU16("len")
+	assert.Nil(t, err)
+	assert.Equal(t, uint16(0x3fff), l)
+	s, err := r.ReadString("string")
+	assert.Nil(t, err)
+	assert.Equal(t, "hi", s)
+	bs, err := r.ReadVecBytes("bytes")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{1, 2}, bs)
+	vec, err := ReadVec(r, "vec", (*Reader).ReadUint16)
+	assert.Nil(t, err)
+	assert.Equal(t, []uint16{7, 8}, vec)
+	assert.Equal(t, 3, r.Remaining())
+	assert.Nil(t, r.Skip(3, "padding"))
+	assert.Equal(t, 0, r.Remaining())
+	assert.Equal(t, w.Len(), r.Offset())
+}
+
+func TestReaderCompactU16(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+		want uint16
+		err  error
+	}{
+		{
+			data: []byte{0x00},
+			want: 0,
+		},
+		{
+			data: []byte{0x7f},
+			want: 0x7f,
+		},
+		{
+			data: []byte{0x80, 0x01},
+			want: 0x80,
+		},
+		{
+			data: []byte{0xff, 0xff, 0x03},
+			want: 0xffff,
+		},
+		{
+			name: "overflow",
+			data: []byte{0xff, 0xff, 0x04},
+			err:  ErrInvalidCompactU16,
+		},
+		{
+			name: "alias",
+			data: []byte{0x80, 0x00},
+			err:  ErrInvalidCompactU16,
+		},
+		{
+			name: "truncated",
+			data: []byte{0x80},
+			err:  ErrUnexpectedEOF,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewReader(tt.data).ReadCompactU16("len")
+			assert.ErrorIs(t, err, tt.err)
+			assert.Equal(t, tt.want, got)
+
+			if tt.err == nil {
+				w := NewWriter()
+				w.WriteCompactU16(tt.want)
+				assert.Equal(t, tt.data, w.Bytes())
+			}
+		})
+	}
+}
+
+func TestReaderError(t *testing.T) {
+	tests := []struct {
+		name  string
+		data  []byte
+		read  func(r *Reader) error
+		field string
+		off   int
+		err   error
+	}{
+		{
+			name: "eof",
+			data: []byte{1, 2, 3},
+			read: func(r *Reader) error {
+				if _, err := r.ReadUint16("a"); err != nil {
+					return err
+				}
+				_, err := r.ReadUint32("b")
+				return err
+			},
+			field: "b",
+			off:   2,
+			err:   ErrUnexpectedEOF,
+		},
+		{
+			name: "skip past the end",
+			data: []byte{1},
+			read: func(r *Reader) error {
+				return r.Skip(2, "padding")
+			},
+			field: "padding",
+			off:   0,
+			err:   ErrUnexpectedEOF,
+		},
+		{
+			name: "bool",
+			data: []byte{0, 2},
+			read: func(r *Reader) error {
+				if _, err := r.ReadUint8("a"); err != nil {
+					return err
+				}
+				_, err := r.ReadBool("is_initialized")
+				return err
+			},
+			field: "is_initialized",
+			off:   1,
+			err:   ErrInvalidBool,
+		},
+		{
+			name: "option",
+			data: []byte{2},
+			read: func(r *Reader) error {
+				_, err := ReadOption(r, "delegate", (*Reader).ReadPublicKey)
+				return err
+			},
+			field: "delegate",
+			off:   0,
+			err:   ErrInvalidOption,
+		},
+		{
+			name: "coption",
+			data: []byte{2, 0, 0, 0},
+			read: func(r *Reader) error {
+				_, err := r.ReadCOptionPublicKey("close_authority")
+				return err
+			},
+			field: "close_authority",
+			off:   0,
+			err:   ErrInvalidOption,
+		},
+		{
+			name: "vec element",
+			data: []byte{2, 0, 0, 0, 1, 0, 2},
+			read: func(r *Reader) error {
+				_, err := ReadVec(r, "items", (*Reader).ReadUint16)
+				return err
+			},
+			field: "items[1]",
+			off:   6,
+			err:   ErrUnexpectedEOF,
+		},
+		{
+			name: "vec length",
+			data: []byte{255, 255, 255, 255, 1},
+			read: func(r *Reader) error {
+				_, err := ReadVec(r, "items", (*Reader).ReadUint8)
+				return err
+			},
+			field: "items",
+			off:   4,
+			err:   ErrUnexpectedEOF,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.read(NewReader(tt.data))
+			assert.ErrorIs(t, err, tt.err)
+
+			var e *Error
+			if assert.True(t, errors.As(err, &e)) {
+				assert.Equal(t, tt.field, e.Field)
+				assert.Equal(t, tt.off, e.Offset)
+			}
+		})
+	}
+}
+
+func TestWriterUint128Overflow(t *testing.T) {
+	w := NewWriter()
+	assert.ErrorIs(t, w.WriteUint128(new(big.Int).Lsh(big.NewInt(1), 128)), ErrUint128Overflow)
+	assert.ErrorIs(t, w.WriteUint128(big.NewInt(-1)), ErrUint128Overflow)
+	assert.Equal(t, 0, w.Len())
+}
+
+func TestGetUint64OutOfBounds(t *testing.T) {
+	curr := 5
+	_, err := GetUint64(&curr, []byte{1, 2, 3})
+	assert.NotNil(t, err)
+
+	curr = 5
+	_, err = GetBytes32(&curr, []byte{1, 2, 3})
+	assert.NotNil(t, err)
+}
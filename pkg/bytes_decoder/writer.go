@@ -0,0 +1,150 @@
+package bytes_decoder
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/big"
+
+	"github.com/qimeila/solana-go-sdk/common"
+)
+
+// Writer is the counterpart of Reader, it appends little endian values to a buffer
+type Writer struct {
+	data []byte
+}
+
+func NewWriter() *Writer {
+	return &Writer{}
+}
+
+// Bytes returns the written data
+func (w *Writer) Bytes() []byte {
+	return w.data
+}
+
+// Len is the number of written bytes
+func (w *Writer) Len() int {
+	return len(w.data)
+}
+
+// Skip writes n zero bytes, e.g. padding or reserved space
+func (w *Writer) Skip(n int) {
+	w.data = append(w.data, make([]byte, n)...)
+}
+
+func (w *Writer) WriteBytes(b []byte) {
+	w.data = append(w.data, b...)
+}
+
+func (w *Writer) WriteUint8(v uint8) {
+	w.data = append(w.data, v)
+}
+
+func (w *Writer) WriteUint16(v uint16) {
+	w.data = binary.LittleEndian.AppendUint16(w.data, v)
+}
+
+func (w *Writer) WriteUint32(v uint32) {
+	w.data = binary.LittleEndian.AppendUint32(w.data, v)
+}
+
+func (w *Writer) WriteUint64(v uint64) {
+	w.data = binary.LittleEndian.AppendUint64(w.data, v)
+}
+
+func (w *Writer) WriteUint128(v *big.Int) error {
+	if v.Sign() < 0 || v.BitLen() > 128 {
+		return fmt.Errorf("%w: %v", ErrUint128Overflow, v)
+	}
+	var be [16]byte
+	v.FillBytes(be[:])
+	for i := 15; i >= 0; i-- {
+		w.data = append(w.data, be[i])
+	}
+	return nil
+}
+
+func (w *Writer) WriteInt8(v int8) {
+	w.WriteUint8(uint8(v))
+}
+
+func (w *Writer) WriteInt16(v int16) {
+	w.WriteUint16(uint16(v))
+}
+
+func (w *Writer) WriteInt32(v int32) {
+	w.WriteUint32(uint32(v))
+}
+
+func (w *Writer) WriteInt64(v int64) {
+	w.WriteUint64(uint64(v))
+}
+
+func (w *Writer) WriteBool(v bool) {
+	if v {
+		w.WriteUint8(1)
+		return
+	}
+	w.WriteUint8(0)
+}
+
+func (w *Writer) WritePublicKey(v common.PublicKey) {
+	w.data = append(w.data, v.Bytes()...)
+}
+
+// WriteOption writes the 1 byte tag of an Option, the value should be written next if some is true
+func (w *Writer) WriteOption(some bool) {
+	w.WriteBool(some)
+}
+
+// WriteCOption writes the 4 bytes tag of a COption, the value should be written next even if some is false
+func (w *Writer) WriteCOption(some bool) {
+	if some {
+		w.WriteUint32(1)
+		return
+	}
+	w.WriteUint32(0)
+}
+
+// WriteCOptionPublicKey writes a COption<Pubkey>, a zero key takes the place of None
+func (w *Writer) WriteCOptionPublicKey(v *common.PublicKey) {
+	w.WriteCOption(v != nil)
+	if v == nil {
+		w.WritePublicKey(common.PublicKey{})
+		return
+	}
+	w.WritePublicKey(*v)
+}
+
+// WriteCompactU16 writes a shortvec length as used in transactions
+func (w *Writer) WriteCompactU16(v uint16) {
+	for {
+		b := uint8(v & 0x7f)
+		v >>= 7
+		if v == 0 {
+			w.WriteUint8(b)
+			return
+		}
+		w.WriteUint8(b | 0x80)
+	}
+}
+
+// WriteString writes a borsh string, a u32 length followed by the bytes
+func (w *Writer) WriteString(v string) {
+	w.WriteUint32(uint32(len(v)))
+	w.data = append(w.data, v...)
+}
+
+// WriteVecBytes writes a borsh Vec<u8>
+func (w *Writer) WriteVecBytes(v []byte) {
+	w.WriteUint32(uint32(len(v)))
+	w.data = append(w.data, v...)
+}
+
+// WriteVec writes a borsh Vec<T> with f writing each element
+func WriteVec[T any](w *Writer, v []T, f func(w *Writer, e T)) {
+	w.WriteUint32(uint32(len(v)))
+	for _, e := range v {
+		f(w, e)
+	}
+}
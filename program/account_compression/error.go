@@ -0,0 +1,11 @@
+package account_compression
+
+import "errors"
+
+var (
+	ErrInvalidAccountOwner    = errors.New("invalid account owner")
+	ErrInvalidAccountDataSize = errors.New("invalid account data size")
+	ErrInvalidAccountData     = errors.New("invalid account data")
+	ErrInvalidCanopy          = errors.New("invalid canopy")
+	ErrInvalidLeafIndex       = errors.New("invalid leaf index")
+)
@@ -0,0 +1,116 @@
+package account_compression
+
+import (
+	"golang.org/x/crypto/sha3"
+)
+
+// EmptyNode returns the root of an empty subtree of the given height, a leaf is level 0
+func EmptyNode(level uint32) Node {
+	var node Node
+	for i := uint32(0); i < level; i++ {
+		node = hashPair(node, node)
+	}
+	return node
+}
+
+// RecomputeRoot hashes leaf up the tree with proof, proof[0] is the leaf's sibling.
+// proof can be nodes or public keys, the form rpc and instructions carry them in.
+func RecomputeRoot[T ~[32]byte](leaf Node, index uint32, proof []T) Node {
+	node := leaf
+	for i, sibling := range proof {
+		if (index>>i)&1 == 0 {
+			node = hashPair(node, Node(sibling))
+		} else {
+			node = hashPair(Node(sibling), node)
+		}
+	}
+	return node
+}
+
+// VerifyProof checks that leaf is at index of the tree with root
+func VerifyProof[T ~[32]byte](root Node, leaf Node, index uint32, proof []T) bool {
+	return RecomputeRoot(leaf, index, proof) == root
+}
+
+// TrimProof drops the top nodes of a full proof which the tree's canopy already holds.
+// The trimmed proof is what bubblegum instructions expect as remaining accounts.
+func TrimProof[T ~[32]byte](proof []T, canopyDepth uint32) []T {
+	if int(canopyDepth) >= len(proof) {
+		return proof[:0]
+	}
+	return proof[:len(proof)-int(canopyDepth)]
+}
+
+// HasRoot reports whether root is the current root or one the change log still remembers.
+// Proofs against those roots are still accepted by the program.
+func (a ConcurrentMerkleTreeAccount) HasRoot(root Node) bool {
+	// only the last BufferSize entries up to the active one are in use, the rest are zeroed
+	n := uint64(len(a.Tree.ChangeLogs))
+	if n == 0 {
+		return false
+	}
+	for i := uint64(0); i < a.Tree.BufferSize && i < n; i++ {
+		if a.Tree.ChangeLogs[(a.Tree.ActiveIndex+n-i)%n].Root == root {
+			return true
+		}
+	}
+	return false
+}
+
+// FillProofFromCanopy appends the nodes the canopy holds to a trimmed proof of the leaf at index,
+// it is what the program does before checking a proof.
+func (a ConcurrentMerkleTreeAccount) FillProofFromCanopy(index uint32, proof []Node) ([]Node, error) {
+	maxDepth := a.Header.MaxDepth
+	depth := a.CanopyDepth()
+	if depth > maxDepth || CanopySize(depth) != uint64(len(a.Canopy))*NodeSize {
+		return nil, ErrInvalidCanopy
+	}
+	if uint64(index) >= uint64(1)<<maxDepth {
+		return nil, ErrInvalidLeafIndex
+	}
+
+	var inferred []Node
+	nodeIndex := ((uint64(1) << maxDepth) + uint64(index)) >> (maxDepth - depth)
+	for nodeIndex > 1 {
+		shifted := nodeIndex - 2
+		cached := shifted + 1
+		if shifted%2 == 1 {
+			cached = shifted - 1
+		}
+		if a.Canopy[cached] == (Node{}) {
+			inferred = append(inferred, EmptyNode(maxDepth-log2(nodeIndex)))
+		} else {
+			inferred = append(inferred, a.Canopy[cached])
+		}
+		nodeIndex >>= 1
+	}
+
+	overlap := len(proof) + len(inferred) - int(maxDepth)
+	if overlap < 0 {
+		overlap = 0
+	}
+	if overlap > len(inferred) {
+		overlap = len(inferred)
+	}
+	filled := make([]Node, 0, len(proof)+len(inferred)-overlap)
+	filled = append(filled, proof...)
+	return append(filled, inferred[overlap:]...), nil
+}
+
+func log2(n uint64) uint32 {
+	l := uint32(0)
+	for n > 1 {
+		n >>= 1
+		l++
+	}
+	return l
+}
+
+func hashPair(left, right Node) Node {
+	h := sha3.NewLegacyKeccak256()
+	h.Write(left[:])
+	h.Write(right[:])
+	var node Node
+	copy(node[:], h.Sum(nil))
+	return node
+}
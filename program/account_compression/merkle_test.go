@@ -0,0 +1,95 @@
+package account_compression
+
+import (
+	"testing"
+
+	"github.com/qimeila/solana-go-sdk/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEmptyNode(t *testing.T) {
+	assert.Equal(t, Node{}, EmptyNode(0))
+	assert.Equal(t, hashPair(Node{}, Node{}), EmptyNode(1))
+	assert.Equal(t, newTestTree(3, nil).root(), EmptyNode(3))
+}
+
+func TestVerifyProof(t *testing.T) {
+	tree := newTestTree(3, []Node{{1}, {2}, {3}, {4}, {5}})
+
+	tests := []struct {
+		name  string
+		leaf  Node
+		index uint32
+		proof []Node
+		want  bool
+	}{
+		{name: "first leaf", leaf: Node{1}, index: 0, proof: tree.proof(0), want: true},
+		{name: "last leaf", leaf: Node{5}, index: 4, proof: tree.proof(4), want: true},
+		{name: "empty leaf", leaf: Node{}, index: 7, proof: tree.proof(7), want: true},
+		{name: "wrong leaf", leaf: Node{2}, index: 0, proof: tree.proof(0), want: false},
+		{name: "wrong index", leaf: Node{1}, index: 1, proof: tree.proof(0), want: false},
+		{name: "short proof", leaf: Node{1}, index: 0, proof: tree.proof(0)[:2], want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, VerifyProof(tree.root(), tt.leaf, tt.index, tt.proof))
+		})
+	}
+
+	// proofs returned by rpc are public keys
+	proof := []common.PublicKey{}
+	for _, node := range tree.proof(4) {
+		proof = append(proof, common.PublicKey(node))
+	}
+	assert.True(t, VerifyProof(tree.root(), Node{5}, 4, proof))
+}
+
+func TestTrimProof(t *testing.T) {
+	proof := []common.PublicKey{{1}, {2}, {3}, {4}}
+	assert.Equal(t, []common.PublicKey{{1}, {2}, {3}, {4}}, TrimProof(proof, 0))
+	assert.Equal(t, []common.PublicKey{{1}, {2}}, TrimProof(proof, 2))
+	assert.Equal(t, []common.PublicKey{}, TrimProof(proof, 4))
+	assert.Equal(t, []common.PublicKey{}, TrimProof(proof, 5))
+}
+
+func TestFillProofFromCanopy(t *testing.T) {
+	tree := newTestTree(4, []Node{{1}, {2}, {3}, {4}, {5}})
+
+	for _, canopyDepth := range []uint32{0, 1, 2, 4} {
+		account, err := ConcurrentMerkleTreeAccountFromData(testAccountData(4, 2, tree, 5, tree.canopy(canopyDepth)))
+		assert.NoError(t, err)
+		assert.Equal(t, canopyDepth, account.CanopyDepth())
+
+		for _, index := range []uint32{0, 4, 9, 15} {
+			trimmed := TrimProof(tree.proof(index), canopyDepth)
+			got, err := account.FillProofFromCanopy(index, trimmed)
+			assert.NoError(t, err)
+			assert.Equal(t, tree.proof(index), got, "canopy depth %d, index %d", canopyDepth, index)
+			assert.True(t, VerifyProof(account.Root(), tree.levels[0][index], index, got))
+		}
+
+		// a full proof is left as it is
+		got, err := account.FillProofFromCanopy(3, tree.proof(3))
+		assert.NoError(t, err)
+		assert.Equal(t, tree.proof(3), got)
+	}
+
+	// depth 3 holds leaves 0 to 7
+	small := newTestTree(3, []Node{{1}})
+	account, err := ConcurrentMerkleTreeAccountFromData(testAccountData(3, 2, small, 1, small.canopy(1)))
+	assert.NoError(t, err)
+	_, err = account.FillProofFromCanopy(8, TrimProof(small.proof(0), 1))
+	assert.ErrorIs(t, err, ErrInvalidLeafIndex)
+}
+
+func TestHasRoot(t *testing.T) {
+	tree := newTestTree(3, []Node{{1}, {2}, {3}})
+	account, err := ConcurrentMerkleTreeAccountFromData(testAccountData(3, 4, tree, 3, nil))
+	assert.NoError(t, err)
+
+	assert.True(t, account.HasRoot(tree.root()))
+	assert.True(t, account.HasRoot(Node{0xaa}))
+	assert.False(t, account.HasRoot(Node{0xbb}))
+	// the unused change log slots are zeroed
+	assert.False(t, account.HasRoot(Node{}))
+}
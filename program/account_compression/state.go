@@ -0,0 +1,214 @@
+package account_compression
+
+import (
+	"encoding/binary"
+
+	"github.com/qimeila/solana-go-sdk/common"
+)
+
+const (
+	// HeaderSize is the size of a merkle tree account's header
+	HeaderSize = 56
+	NodeSize   = 32
+)
+
+// Node is a node of a merkle tree, a keccak256 hash
+type Node = [32]byte
+
+type CompressionAccountType uint8
+
+const (
+	CompressionAccountTypeUninitialized CompressionAccountType = iota
+	CompressionAccountTypeConcurrentMerkleTree
+)
+
+type HeaderVersion uint8
+
+const (
+	HeaderVersionV1 HeaderVersion = iota
+)
+
+type ConcurrentMerkleTreeHeader struct {
+	AccountType   CompressionAccountType
+	Version       HeaderVersion
+	MaxBufferSize uint32
+	MaxDepth      uint32
+	// Authority is the account allowed to modify the tree, the tree config for bubblegum trees
+	Authority          common.PublicKey
+	CreationSlot       uint64
+	IsBatchInitialized bool
+}
+
+// ChangeLog records a change of the tree so proofs against a recent root stay usable
+type ChangeLog struct {
+	Root  Node
+	Path  []Node
+	Index uint32
+}
+
+type Path struct {
+	Proof []Node
+	Leaf  Node
+	Index uint32
+}
+
+type ConcurrentMerkleTree struct {
+	SequenceNumber uint64
+	ActiveIndex    uint64
+	BufferSize     uint64
+	ChangeLogs     []ChangeLog
+	RightmostProof Path
+}
+
+type ConcurrentMerkleTreeAccount struct {
+	Header ConcurrentMerkleTreeHeader
+	Tree   ConcurrentMerkleTree
+	// Canopy caches the top levels of the tree below the root, level by level
+	Canopy []Node
+}
+
+// Root returns the current root of the tree
+func (a ConcurrentMerkleTreeAccount) Root() Node {
+	if len(a.Tree.ChangeLogs) == 0 {
+		return Node{}
+	}
+	return a.Tree.ChangeLogs[a.Tree.ActiveIndex].Root
+}
+
+// CanopyDepth returns how many levels below the root the canopy caches
+func (a ConcurrentMerkleTreeAccount) CanopyDepth() uint32 {
+	return canopyDepth(len(a.Canopy))
+}
+
+// NumLeaves returns how many leaves have been appended to the tree
+func (a ConcurrentMerkleTreeAccount) NumLeaves() uint64 {
+	if len(a.Tree.ChangeLogs) == 0 {
+		return 0
+	}
+	return uint64(a.Tree.RightmostProof.Index)
+}
+
+// ConcurrentMerkleTreeSize is the size of the tree without its header and canopy
+func ConcurrentMerkleTreeSize(maxDepth, maxBufferSize uint32) uint64 {
+	changeLogSize := uint64(NodeSize + NodeSize*maxDepth + 8)
+	pathSize := uint64(NodeSize*maxDepth + NodeSize + 8)
+	return 24 + uint64(maxBufferSize)*changeLogSize + pathSize
+}
+
+// CanopySize is the size of a canopy which caches canopyDepth levels
+func CanopySize(canopyDepth uint32) uint64 {
+	return ((uint64(1) << (canopyDepth + 1)) - 2) * NodeSize
+}
+
+// MerkleTreeAccountSize is the space a merkle tree account needs
+func MerkleTreeAccountSize(maxDepth, maxBufferSize, canopyDepth uint32) uint64 {
+	return HeaderSize + ConcurrentMerkleTreeSize(maxDepth, maxBufferSize) + CanopySize(canopyDepth)
+}
+
+func ConcurrentMerkleTreeAccountFromData(data []byte) (ConcurrentMerkleTreeAccount, error) {
+	if len(data) < HeaderSize {
+		return ConcurrentMerkleTreeAccount{}, ErrInvalidAccountDataSize
+	}
+
+	header := ConcurrentMerkleTreeHeader{
+		AccountType:        CompressionAccountType(data[0]),
+		Version:            HeaderVersion(data[1]),
+		MaxBufferSize:      binary.LittleEndian.Uint32(data[2:]),
+		MaxDepth:           binary.LittleEndian.Uint32(data[6:]),
+		Authority:          common.PublicKeyFromBytes(data[10:42]),
+		CreationSlot:       binary.LittleEndian.Uint64(data[42:]),
+		IsBatchInitialized: data[50] == 1,
+	}
+	if header.AccountType != CompressionAccountTypeConcurrentMerkleTree || header.Version != HeaderVersionV1 {
+		return ConcurrentMerkleTreeAccount{}, ErrInvalidAccountData
+	}
+	if header.MaxDepth == 0 || header.MaxDepth > 30 || header.MaxBufferSize == 0 {
+		return ConcurrentMerkleTreeAccount{}, ErrInvalidAccountData
+	}
+
+	treeSize := ConcurrentMerkleTreeSize(header.MaxDepth, header.MaxBufferSize)
+	if uint64(len(data)) < HeaderSize+treeSize {
+		return ConcurrentMerkleTreeAccount{}, ErrInvalidAccountDataSize
+	}
+	d := decoder{data: data[HeaderSize : HeaderSize+treeSize]}
+
+	tree := ConcurrentMerkleTree{
+		SequenceNumber: d.u64(),
+		ActiveIndex:    d.u64(),
+		BufferSize:     d.u64(),
+		ChangeLogs:     make([]ChangeLog, 0, header.MaxBufferSize),
+	}
+	if tree.ActiveIndex >= uint64(header.MaxBufferSize) || tree.BufferSize > uint64(header.MaxBufferSize) {
+		return ConcurrentMerkleTreeAccount{}, ErrInvalidAccountData
+	}
+	for i := uint32(0); i < header.MaxBufferSize; i++ {
+		changeLog := ChangeLog{Root: d.node(), Path: d.nodes(header.MaxDepth)}
+		changeLog.Index = d.u32()
+		d.u32() // padding
+		tree.ChangeLogs = append(tree.ChangeLogs, changeLog)
+	}
+	tree.RightmostProof = Path{Proof: d.nodes(header.MaxDepth), Leaf: d.node()}
+	tree.RightmostProof.Index = d.u32()
+
+	canopyData := data[HeaderSize+treeSize:]
+	if len(canopyData)%NodeSize != 0 || CanopySize(canopyDepth(len(canopyData)/NodeSize)) != uint64(len(canopyData)) {
+		return ConcurrentMerkleTreeAccount{}, ErrInvalidCanopy
+	}
+	canopy := decoder{data: canopyData}
+
+	return ConcurrentMerkleTreeAccount{
+		Header: header,
+		Tree:   tree,
+		Canopy: canopy.nodes(uint32(len(canopyData) / NodeSize)),
+	}, nil
+}
+
+func DeserializeConcurrentMerkleTreeAccount(data []byte, accountOwner common.PublicKey) (ConcurrentMerkleTreeAccount, error) {
+	if accountOwner != common.SPLAccountCompressionProgramID {
+		return ConcurrentMerkleTreeAccount{}, ErrInvalidAccountOwner
+	}
+	return ConcurrentMerkleTreeAccountFromData(data)
+}
+
+// canopyDepth returns the depth of a canopy of n nodes, n = 2^(depth+1) - 2
+func canopyDepth(n int) uint32 {
+	depth := uint32(0)
+	for n > 0 {
+		depth++
+		n = (n - 2) / 2
+	}
+	return depth
+}
+
+// decoder reads little endian values, the size is checked before decoding
+type decoder struct {
+	data    []byte
+	current int
+}
+
+func (d *decoder) u32() uint32 {
+	v := binary.LittleEndian.Uint32(d.data[d.current:])
+	d.current += 4
+	return v
+}
+
+func (d *decoder) u64() uint64 {
+	v := binary.LittleEndian.Uint64(d.data[d.current:])
+	d.current += 8
+	return v
+}
+
+func (d *decoder) node() Node {
+	var n Node
+	copy(n[:], d.data[d.current:d.current+NodeSize])
+	d.current += NodeSize
+	return n
+}
+
+func (d *decoder) nodes(count uint32) []Node {
+	nodes := make([]Node, 0, count)
+	for i := uint32(0); i < count; i++ {
+		nodes = append(nodes, d.node())
+	}
+	return nodes
+}
@@ -0,0 +1,176 @@
+package account_compression
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/qimeila/solana-go-sdk/common"
+	"github.com/stretchr/testify/assert"
+)
+
+// testTree is a full merkle tree, levels[0] are the leaves and levels[depth] is the root
+type testTree struct {
+	levels [][]Node
+}
+
+func newTestTree(depth uint32, leaves []Node) testTree {
+	level := make([]Node, 1<<depth)
+	copy(level, leaves)
+	levels := [][]Node{level}
+	for d := uint32(0); d < depth; d++ {
+		next := make([]Node, len(level)/2)
+		for i := range next {
+			next[i] = hashPair(level[2*i], level[2*i+1])
+		}
+		levels = append(levels, next)
+		level = next
+	}
+	return testTree{levels: levels}
+}
+
+func (t testTree) root() Node {
+	return t.levels[len(t.levels)-1][0]
+}
+
+func (t testTree) proof(index uint32) []Node {
+	proof := []Node{}
+	for d := 0; d < len(t.levels)-1; d++ {
+		proof = append(proof, t.levels[d][index^1])
+		index >>= 1
+	}
+	return proof
+}
+
+// canopy lays out the top levels below the root the way the program stores them, empty subtrees are zero
+func (t testTree) canopy(depth uint32) []Node {
+	canopy := []Node{}
+	top := len(t.levels) - 1
+	for d := 1; d <= int(depth); d++ {
+		for _, node := range t.levels[top-d] {
+			if node == EmptyNode(uint32(top-d)) {
+				node = Node{}
+			}
+			canopy = append(canopy, node)
+		}
+	}
+	return canopy
+}
+
+func testAccountData(maxDepth, maxBufferSize uint32, tree testTree, numLeaves uint32, canopy []Node) []byte {
+	authority := common.PublicKeyFromString("9BKWqDHfHZh9j39xakYVMdr6hXmCLHH5VfCpeq2idU9L")
+
+	data := []byte{1, 0}
+	data = binary.LittleEndian.AppendUint32(data, maxBufferSize)
+	data = binary.LittleEndian.AppendUint32(data, maxDepth)
+	data = append(data, authority.Bytes()...)
+	data = binary.LittleEndian.AppendUint64(data, 250000000)
+	data = append(data, make([]byte, 6)...)
+
+	data = binary.LittleEndian.AppendUint64(data, uint64(numLeaves)) // sequence number
+	data = binary.LittleEndian.AppendUint64(data, 1)                 // active index
+	data = binary.LittleEndian.AppendUint64(data, 2)                 // buffer size
+	for i := uint32(0); i < maxBufferSize; i++ {
+		root := Node{}
+		if i == 0 {
+			root = Node{0xaa}
+		}
+		if i == 1 {
+			root = tree.root()
+		}
+		data = append(data, root[:]...)
+		data = append(data, make([]byte, NodeSize*maxDepth)...)
+		data = binary.LittleEndian.AppendUint32(data, i)
+		data = append(data, 0, 0, 0, 0)
+	}
+	for _, node := range tree.proof(numLeaves - 1) {
+		data = append(data, node[:]...)
+	}
+	data = append(data, tree.levels[0][numLeaves-1][:]...)
+	data = binary.LittleEndian.AppendUint32(data, numLeaves)
+	data = append(data, 0, 0, 0, 0)
+
+	for _, node := range canopy {
+		data = append(data, node[:]...)
+	}
+	return data
+}
+
+func TestDeserializeConcurrentMerkleTreeAccount(t *testing.T) {
+	tree := newTestTree(3, []Node{{1}, {2}, {3}})
+	data := testAccountData(3, 4, tree, 3, tree.canopy(1))
+
+	type args struct {
+		data         []byte
+		accountOwner common.PublicKey
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr error
+	}{
+		{
+			args:    args{data: data, accountOwner: common.SystemProgramID},
+			wantErr: ErrInvalidAccountOwner,
+		},
+		{
+			args:    args{data: data[:HeaderSize-1], accountOwner: common.SPLAccountCompressionProgramID},
+			wantErr: ErrInvalidAccountDataSize,
+		},
+		{
+			args:    args{data: data[:HeaderSize+100], accountOwner: common.SPLAccountCompressionProgramID},
+			wantErr: ErrInvalidAccountDataSize,
+		},
+		{
+			args:    args{data: append([]byte{0}, data[1:]...), accountOwner: common.SPLAccountCompressionProgramID},
+			wantErr: ErrInvalidAccountData,
+		},
+		{
+			args:    args{data: data[:len(data)-NodeSize], accountOwner: common.SPLAccountCompressionProgramID},
+			wantErr: ErrInvalidCanopy,
+		},
+		{
+			args: args{data: data, accountOwner: common.SPLAccountCompressionProgramID},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := DeserializeConcurrentMerkleTreeAccount(tt.args.data, tt.args.accountOwner)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+
+	got, err := DeserializeConcurrentMerkleTreeAccount(data, common.SPLAccountCompressionProgramID)
+	assert.NoError(t, err)
+	assert.Equal(t, ConcurrentMerkleTreeHeader{
+		AccountType:   CompressionAccountTypeConcurrentMerkleTree,
+		Version:       HeaderVersionV1,
+		MaxBufferSize: 4,
+		MaxDepth:      3,
+		Authority:     common.PublicKeyFromString("9BKWqDHfHZh9j39xakYVMdr6hXmCLHH5VfCpeq2idU9L"),
+		CreationSlot:  250000000,
+	}, got.Header)
+	assert.Equal(t, uint64(3), got.Tree.SequenceNumber)
+	assert.Len(t, got.Tree.ChangeLogs, 4)
+	assert.Equal(t, uint32(2), got.Tree.ChangeLogs[2].Index)
+	assert.Len(t, got.Tree.ChangeLogs[2].Path, 3)
+	assert.Equal(t, Path{Proof: tree.proof(2), Leaf: Node{3}, Index: 3}, got.Tree.RightmostProof)
+	assert.Equal(t, tree.root(), got.Root())
+	assert.Equal(t, uint64(3), got.NumLeaves())
+	assert.Equal(t, uint32(1), got.CanopyDepth())
+	assert.Equal(t, []Node{tree.levels[2][0], {}}, got.Canopy)
+	assert.Equal(t, uint64(len(data)), MerkleTreeAccountSize(3, 4, 1))
+}
+
+func TestMerkleTreeAccountSize(t *testing.T) {
+	tests := []struct {
+		maxDepth, maxBufferSize, canopyDepth uint32
+		want                                 uint64
+	}{
+		{maxDepth: 3, maxBufferSize: 8, canopyDepth: 0, want: 1304},
+		{maxDepth: 14, maxBufferSize: 64, canopyDepth: 0, want: 31800},
+		{maxDepth: 14, maxBufferSize: 64, canopyDepth: 11, want: 162808},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, MerkleTreeAccountSize(tt.maxDepth, tt.maxBufferSize, tt.canopyDepth))
+	}
+}
@@ -0,0 +1,186 @@
+package associated_token_account
+
+import (
+	"container/list"
+	"errors"
+	"fmt"
+	"runtime"
+	"sync"
+
+	"github.com/qimeila/solana-go-sdk/common"
+	"github.com/qimeila/solana-go-sdk/program/token"
+)
+
+var (
+	ErrNotAssociatedTokenAccount = errors.New("not the associated token account")
+	ErrTokenAccountMintMismatch  = errors.New("token account mint mismatch")
+)
+
+// GetAssociatedTokenAddress derives the associated token account of a wallet for a mint.
+// tokenProgramID must be the program which owns the mint, e.g. common.Token2022ProgramID for Token-2022 mints.
+func GetAssociatedTokenAddress(wallet, mint, tokenProgramID common.PublicKey) (common.PublicKey, uint8, error) {
+	return common.FindProgramAddress(
+		[][]byte{wallet.Bytes(), tokenProgramID.Bytes(), mint.Bytes()},
+		common.SPLAssociatedTokenAccountProgramID,
+	)
+}
+
+type addressCacheKey struct {
+	wallet         common.PublicKey
+	mint           common.PublicKey
+	tokenProgramID common.PublicKey
+}
+
+type addressCacheEntry struct {
+	key     addressCacheKey
+	address common.PublicKey
+	bump    uint8
+}
+
+// AddressCache memoizes associated token addresses. It is safe for concurrent use.
+type AddressCache struct {
+	mu      sync.Mutex
+	size    int
+	order   *list.List
+	entries map[addressCacheKey]*list.Element
+}
+
+// NewAddressCache returns a cache which holds up to size addresses, the least recently used one is evicted once it is full.
+// A size <= 0 means unlimited.
+func NewAddressCache(size int) *AddressCache {
+	return &AddressCache{
+		size:    size,
+		order:   list.New(),
+		entries: map[addressCacheKey]*list.Element{},
+	}
+}
+
+func (c *AddressCache) GetAssociatedTokenAddress(wallet, mint, tokenProgramID common.PublicKey) (common.PublicKey, uint8, error) {
+	key := addressCacheKey{wallet: wallet, mint: mint, tokenProgramID: tokenProgramID}
+
+	c.mu.Lock()
+	if e, ok := c.entries[key]; ok {
+		c.order.MoveToFront(e)
+		v := e.Value.(*addressCacheEntry)
+		c.mu.Unlock()
+		return v.address, v.bump, nil
+	}
+	c.mu.Unlock()
+
+	address, bump, err := GetAssociatedTokenAddress(wallet, mint, tokenProgramID)
+	if err != nil {
+		return common.PublicKey{}, 0, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.entries[key]; ok {
+		c.order.MoveToFront(e)
+		return address, bump, nil
+	}
+	c.entries[key] = c.order.PushFront(&addressCacheEntry{key: key, address: address, bump: bump})
+	if c.size > 0 && c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*addressCacheEntry).key)
+	}
+
+	return address, bump, nil
+}
+
+// IsAssociatedTokenAddress is the package level IsAssociatedTokenAddress backed by the cache
+func (c *AddressCache) IsAssociatedTokenAddress(address, wallet, mint, tokenProgramID common.PublicKey) bool {
+	return isAssociatedTokenAddress(c.GetAssociatedTokenAddress, address, wallet, mint, tokenProgramID)
+}
+
+// ValidateAssociatedTokenAccount is the package level ValidateAssociatedTokenAccount backed by the cache
+func (c *AddressCache) ValidateAssociatedTokenAccount(address common.PublicKey, account token.TokenAccount, tokenProgramID common.PublicKey, mint *common.PublicKey) error {
+	return validateAssociatedTokenAccount(c.GetAssociatedTokenAddress, address, account, tokenProgramID, mint)
+}
+
+func (c *AddressCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+var defaultAddressCache = NewAddressCache(100_000)
+
+// GetAssociatedTokenAddressCached is GetAssociatedTokenAddress backed by a process wide cache
+func GetAssociatedTokenAddressCached(wallet, mint, tokenProgramID common.PublicKey) (common.PublicKey, uint8, error) {
+	return defaultAddressCache.GetAssociatedTokenAddress(wallet, mint, tokenProgramID)
+}
+
+// GetAssociatedTokenAddresses derives the associated token accounts of many wallets for one mint.
+// The derivation is spread across all cpus, the output is in the same order as wallets.
+func GetAssociatedTokenAddresses(wallets []common.PublicKey, mint, tokenProgramID common.PublicKey) ([]common.PublicKey, error) {
+	output := make([]common.PublicKey, len(wallets))
+	if len(wallets) == 0 {
+		return output, nil
+	}
+
+	workers := runtime.GOMAXPROCS(0)
+	if workers > len(wallets) {
+		workers = len(wallets)
+	}
+	chunk := (len(wallets) + workers - 1) / workers
+
+	var wg sync.WaitGroup
+	errs := make([]error, workers)
+	for w := 0; w < workers; w++ {
+		start, end := w*chunk, (w+1)*chunk
+		if end > len(wallets) {
+			end = len(wallets)
+		}
+		wg.Add(1)
+		go func(w, start, end int) {
+			defer wg.Done()
+			for i := start; i < end; i++ {
+				address, _, err := GetAssociatedTokenAddress(wallets[i], mint, tokenProgramID)
+				if err != nil {
+					errs[w] = fmt.Errorf("failed to derive associated token address for %v, err: %w", wallets[i], err)
+					return
+				}
+				output[i] = address
+			}
+		}(w, start, end)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return output, nil
+}
+
+type deriveFunc func(wallet, mint, tokenProgramID common.PublicKey) (common.PublicKey, uint8, error)
+
+// IsAssociatedTokenAddress reports whether address is the canonical associated token account of wallet for mint.
+// It derives the address every time, use AddressCache.IsAssociatedTokenAddress to memoize it.
+func IsAssociatedTokenAddress(address, wallet, mint, tokenProgramID common.PublicKey) bool {
+	return isAssociatedTokenAddress(GetAssociatedTokenAddress, address, wallet, mint, tokenProgramID)
+}
+
+func isAssociatedTokenAddress(derive deriveFunc, address, wallet, mint, tokenProgramID common.PublicKey) bool {
+	expected, _, err := derive(wallet, mint, tokenProgramID)
+	return err == nil && expected == address
+}
+
+// ValidateAssociatedTokenAccount checks that a token account at address is the canonical associated token account
+// of its owner. The optional mint is compared with the account's mint.
+// It derives the address every time, use AddressCache.ValidateAssociatedTokenAccount to memoize it.
+func ValidateAssociatedTokenAccount(address common.PublicKey, account token.TokenAccount, tokenProgramID common.PublicKey, mint *common.PublicKey) error {
+	return validateAssociatedTokenAccount(GetAssociatedTokenAddress, address, account, tokenProgramID, mint)
+}
+
+func validateAssociatedTokenAccount(derive deriveFunc, address common.PublicKey, account token.TokenAccount, tokenProgramID common.PublicKey, mint *common.PublicKey) error {
+	if mint != nil && account.Mint != *mint {
+		return fmt.Errorf("%w, expected: %v, got: %v", ErrTokenAccountMintMismatch, *mint, account.Mint)
+	}
+	if !isAssociatedTokenAddress(derive, address, account.Owner, account.Mint, tokenProgramID) {
+		return fmt.Errorf("%w, address: %v, owner: %v, mint: %v", ErrNotAssociatedTokenAccount, address, account.Owner, account.Mint)
+	}
+	return nil
+}
@@ -0,0 +1,162 @@
+package associated_token_account
+
+import (
+	"testing"
+
+	"github.com/qimeila/solana-go-sdk/common"
+	"github.com/qimeila/solana-go-sdk/program/token"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAssociatedTokenAddress(t *testing.T) {
+	type args struct {
+		wallet         common.PublicKey
+		mint           common.PublicKey
+		tokenProgramID common.PublicKey
+	}
+	tests := []struct {
+		name     string
+		args     args
+		want     common.PublicKey
+		wantBump uint8
+	}{
+		{
+			args: args{
+				wallet:         common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"),
+				mint:           common.PublicKeyFromString("8765cK2Vucsic6NA5nm4cfkrCzusaFVqBf6Pk31tGkXH"),
+				tokenProgramID: common.TokenProgramID,
+			},
+			want:     common.PublicKeyFromString("HLzppk6ohPg9Ab99XTFhsa6FcG14Au3rTijGe9c8QHp1"),
+			wantBump: 254,
+		},
+		{
+			args: args{
+				wallet:         common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"),
+				mint:           common.PublicKeyFromString("8765cK2Vucsic6NA5nm4cfkrCzusaFVqBf6Pk31tGkXH"),
+				tokenProgramID: common.Token2022ProgramID,
+			},
+			want:     common.PublicKeyFromString("Zt9aHhoLTH4d4MBacxVEkXscdXrA4pof2vvsnmDaWRL"),
+			wantBump: 254,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, bump, err := GetAssociatedTokenAddress(tt.args.wallet, tt.args.mint, tt.args.tokenProgramID)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantBump, bump)
+
+			got, bump, err = GetAssociatedTokenAddressCached(tt.args.wallet, tt.args.mint, tt.args.tokenProgramID)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantBump, bump)
+		})
+	}
+}
+
+func TestAddressCache(t *testing.T) {
+	mint := common.PublicKeyFromString("8765cK2Vucsic6NA5nm4cfkrCzusaFVqBf6Pk31tGkXH")
+	c := NewAddressCache(2)
+
+	for _, wallet := range []common.PublicKey{
+		common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"),
+		common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"),
+		common.PublicKeyFromString("27kVX7JpPZ1bsrSckbR76mV6GeRqtrjoddubfg2zBpHZ"),
+	} {
+		_, _, err := c.GetAssociatedTokenAddress(wallet, mint, common.TokenProgramID)
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, 2, c.Len())
+
+	// touch the first wallet so the second one is the least recently used
+	_, _, err := c.GetAssociatedTokenAddress(common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"), mint, common.TokenProgramID)
+	assert.Nil(t, err)
+
+	_, _, err = c.GetAssociatedTokenAddress(mint, mint, common.TokenProgramID)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, c.Len())
+	assert.Contains(t, c.entries, addressCacheKey{wallet: common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"), mint: mint, tokenProgramID: common.TokenProgramID})
+	assert.Contains(t, c.entries, addressCacheKey{wallet: mint, mint: mint, tokenProgramID: common.TokenProgramID})
+	assert.NotContains(t, c.entries, addressCacheKey{wallet: common.PublicKeyFromString("27kVX7JpPZ1bsrSckbR76mV6GeRqtrjoddubfg2zBpHZ"), mint: mint, tokenProgramID: common.TokenProgramID})
+}
+
+func TestGetAssociatedTokenAddresses(t *testing.T) {
+	mint := common.PublicKeyFromString("8765cK2Vucsic6NA5nm4cfkrCzusaFVqBf6Pk31tGkXH")
+
+	wallets := make([]common.PublicKey, 0, 100)
+	for i := 0; i < 50; i++ {
+		wallets = append(wallets,
+			common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"),
+			common.PublicKeyFromString("27kVX7JpPZ1bsrSckbR76mV6GeRqtrjoddubfg2zBpHZ"),
+		)
+	}
+
+	got, err := GetAssociatedTokenAddresses(wallets, mint, common.TokenProgramID)
+	assert.Nil(t, err)
+	assert.Len(t, got, len(wallets))
+	for i := 0; i < len(got); i += 2 {
+		assert.Equal(t, common.PublicKeyFromString("HLzppk6ohPg9Ab99XTFhsa6FcG14Au3rTijGe9c8QHp1"), got[i])
+		assert.Equal(t, common.PublicKeyFromString("Dv7h5fUiPoiPAT9tKVyzAPGkpEizKtwpwEJwjk3oUAVu"), got[i+1])
+	}
+
+	got, err = GetAssociatedTokenAddresses(nil, mint, common.TokenProgramID)
+	assert.Nil(t, err)
+	assert.Empty(t, got)
+}
+
+func TestValidateAssociatedTokenAccount(t *testing.T) {
+	owner := common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7")
+	mint := common.PublicKeyFromString("8765cK2Vucsic6NA5nm4cfkrCzusaFVqBf6Pk31tGkXH")
+	account := token.TokenAccount{Mint: mint, Owner: owner}
+
+	type args struct {
+		address        common.PublicKey
+		tokenProgramID common.PublicKey
+		mint           *common.PublicKey
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr error
+	}{
+		{
+			args: args{
+				address:        common.PublicKeyFromString("HLzppk6ohPg9Ab99XTFhsa6FcG14Au3rTijGe9c8QHp1"),
+				tokenProgramID: common.TokenProgramID,
+				mint:           &mint,
+			},
+			wantErr: nil,
+		},
+		{
+			args: args{
+				address:        common.PublicKeyFromString("HLzppk6ohPg9Ab99XTFhsa6FcG14Au3rTijGe9c8QHp1"),
+				tokenProgramID: common.Token2022ProgramID,
+			},
+			wantErr: ErrNotAssociatedTokenAccount,
+		},
+		{
+			args: args{
+				address:        common.PublicKeyFromString("Dv7h5fUiPoiPAT9tKVyzAPGkpEizKtwpwEJwjk3oUAVu"),
+				tokenProgramID: common.TokenProgramID,
+			},
+			wantErr: ErrNotAssociatedTokenAccount,
+		},
+		{
+			args: args{
+				address:        common.PublicKeyFromString("HLzppk6ohPg9Ab99XTFhsa6FcG14Au3rTijGe9c8QHp1"),
+				tokenProgramID: common.TokenProgramID,
+				mint:           &owner,
+			},
+			wantErr: ErrTokenAccountMintMismatch,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateAssociatedTokenAccount(tt.args.address, account, tt.args.tokenProgramID, tt.args.mint)
+			assert.ErrorIs(t, err, tt.wantErr)
+
+			err = NewAddressCache(1).ValidateAssociatedTokenAccount(tt.args.address, account, tt.args.tokenProgramID, tt.args.mint)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
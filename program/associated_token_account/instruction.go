@@ -1,8 +1,8 @@
 package associated_token_account
 
 import (
-	"github.com/near/borsh-go"
 	"github.com/qimeila/solana-go-sdk/common"
+	"github.com/qimeila/solana-go-sdk/pkg/borsh"
 	"github.com/qimeila/solana-go-sdk/types"
 )
 
@@ -53,6 +53,8 @@ type CreateParam struct {
 	Owner                  common.PublicKey
 	Mint                   common.PublicKey
 	AssociatedTokenAccount common.PublicKey
+	// TokenProgramID is common.TokenProgramID by default, use common.Token2022ProgramID for Token-2022 mints
+	TokenProgramID common.PublicKey
 }
 
 // Create creates an associated token account for the given wallet address and token mint. Return an error if the account exists.
@@ -74,7 +76,7 @@ func Create(param CreateParam) types.Instruction {
 			{PubKey: param.Owner, IsSigner: false, IsWritable: false},
 			{PubKey: param.Mint, IsSigner: false, IsWritable: false},
 			{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
-			{PubKey: common.TokenProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: tokenProgramIDOrDefault(param.TokenProgramID), IsSigner: false, IsWritable: false},
 			{PubKey: common.SysVarRentPubkey, IsSigner: false, IsWritable: false},
 		},
 		Data: data,
@@ -86,6 +88,8 @@ type CreateIdempotentParam struct {
 	Owner                  common.PublicKey
 	Mint                   common.PublicKey
 	AssociatedTokenAccount common.PublicKey
+	// TokenProgramID is common.TokenProgramID by default, use common.Token2022ProgramID for Token-2022 mints
+	TokenProgramID common.PublicKey
 }
 
 // CreateIdempotent creates an associated token account for the given wallet address and token mint,
@@ -108,7 +112,7 @@ func CreateIdempotent(param CreateIdempotentParam) types.Instruction {
 			{PubKey: param.Owner, IsSigner: false, IsWritable: false},
 			{PubKey: param.Mint, IsSigner: false, IsWritable: false},
 			{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
-			{PubKey: common.TokenProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: tokenProgramIDOrDefault(param.TokenProgramID), IsSigner: false, IsWritable: false},
 			{PubKey: common.SysVarRentPubkey, IsSigner: false, IsWritable: false},
 		},
 		Data: data,
@@ -149,3 +153,10 @@ func RecoverNested(param RecoverNestedParam) types.Instruction {
 		Data: data,
 	}
 }
+
+func tokenProgramIDOrDefault(tokenProgramID common.PublicKey) common.PublicKey {
+	if tokenProgramID == (common.PublicKey{}) {
+		return common.TokenProgramID
+	}
+	return tokenProgramID
+}
@@ -0,0 +1,299 @@
+package bpf_loader_upgradeable
+
+import (
+	"encoding/binary"
+
+	"github.com/qimeila/solana-go-sdk/common"
+	"github.com/qimeila/solana-go-sdk/pkg/bincode"
+	"github.com/qimeila/solana-go-sdk/types"
+)
+
+type Instruction uint32
+
+const (
+	InstructionInitializeBuffer Instruction = iota
+	InstructionWrite
+	InstructionDeployWithMaxDataLen
+	InstructionUpgrade
+	InstructionSetAuthority
+	InstructionClose
+	InstructionExtendProgram
+	InstructionSetAuthorityChecked
+)
+
+type InitializeBufferParam struct {
+	Buffer    common.PublicKey
+	Authority common.PublicKey
+}
+
+// InitializeBuffer initializes a buffer account which has been created with BufferSize bytes
+func InitializeBuffer(param InitializeBufferParam) types.Instruction {
+	data, err := bincode.SerializeData(struct {
+		Instruction Instruction
+	}{
+		Instruction: InstructionInitializeBuffer,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: common.BPFLoaderUpgradeableProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: param.Buffer, IsSigner: false, IsWritable: true},
+			{PubKey: param.Authority, IsSigner: false, IsWritable: false},
+		},
+		Data: data,
+	}
+}
+
+type WriteParam struct {
+	Buffer    common.PublicKey
+	Authority common.PublicKey
+	// Offset is where Bytes go in the program, it doesn't count the buffer's metadata
+	Offset uint32
+	Bytes  []byte
+}
+
+// Write writes a chunk of a program into a buffer
+func Write(param WriteParam) types.Instruction {
+	data, err := bincode.SerializeData(struct {
+		Instruction Instruction
+		Offset      uint32
+	}{
+		Instruction: InstructionWrite,
+		Offset:      param.Offset,
+	})
+	if err != nil {
+		panic(err)
+	}
+	data = binary.LittleEndian.AppendUint64(data, uint64(len(param.Bytes)))
+	data = append(data, param.Bytes...)
+
+	return types.Instruction{
+		ProgramID: common.BPFLoaderUpgradeableProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: param.Buffer, IsSigner: false, IsWritable: true},
+			{PubKey: param.Authority, IsSigner: true, IsWritable: false},
+		},
+		Data: data,
+	}
+}
+
+type DeployWithMaxDataLenParam struct {
+	Payer common.PublicKey
+	// ProgramData is the program data address, see GetProgramDataAddress
+	ProgramData common.PublicKey
+	// Program has to be created with ProgramSize bytes and owned by the loader
+	Program    common.PublicKey
+	Buffer     common.PublicKey
+	Authority  common.PublicKey
+	MaxDataLen uint64
+}
+
+// DeployWithMaxDataLen deploys a program from a buffer, the buffer is closed into the program data account
+func DeployWithMaxDataLen(param DeployWithMaxDataLenParam) types.Instruction {
+	data, err := bincode.SerializeData(struct {
+		Instruction Instruction
+		MaxDataLen  uint64
+	}{
+		Instruction: InstructionDeployWithMaxDataLen,
+		MaxDataLen:  param.MaxDataLen,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: common.BPFLoaderUpgradeableProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: param.Payer, IsSigner: true, IsWritable: true},
+			{PubKey: param.ProgramData, IsSigner: false, IsWritable: true},
+			{PubKey: param.Program, IsSigner: false, IsWritable: true},
+			{PubKey: param.Buffer, IsSigner: false, IsWritable: true},
+			{PubKey: common.SysVarRentPubkey, IsSigner: false, IsWritable: false},
+			{PubKey: common.SysVarClockPubkey, IsSigner: false, IsWritable: false},
+			{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: param.Authority, IsSigner: true, IsWritable: false},
+		},
+		Data: data,
+	}
+}
+
+type UpgradeParam struct {
+	ProgramData common.PublicKey
+	Program     common.PublicKey
+	Buffer      common.PublicKey
+	// Spill receives the buffer's lamports
+	Spill     common.PublicKey
+	Authority common.PublicKey
+}
+
+// Upgrade replaces a program with the content of a buffer
+func Upgrade(param UpgradeParam) types.Instruction {
+	data, err := bincode.SerializeData(struct {
+		Instruction Instruction
+	}{
+		Instruction: InstructionUpgrade,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: common.BPFLoaderUpgradeableProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: param.ProgramData, IsSigner: false, IsWritable: true},
+			{PubKey: param.Program, IsSigner: false, IsWritable: true},
+			{PubKey: param.Buffer, IsSigner: false, IsWritable: true},
+			{PubKey: param.Spill, IsSigner: false, IsWritable: true},
+			{PubKey: common.SysVarRentPubkey, IsSigner: false, IsWritable: false},
+			{PubKey: common.SysVarClockPubkey, IsSigner: false, IsWritable: false},
+			{PubKey: param.Authority, IsSigner: true, IsWritable: false},
+		},
+		Data: data,
+	}
+}
+
+type SetAuthorityParam struct {
+	// Account is a buffer or a program data account
+	Account   common.PublicKey
+	Authority common.PublicKey
+	// NewAuthority nil makes a program immutable, a buffer always needs one
+	NewAuthority *common.PublicKey
+}
+
+func SetAuthority(param SetAuthorityParam) types.Instruction {
+	data, err := bincode.SerializeData(struct {
+		Instruction Instruction
+	}{
+		Instruction: InstructionSetAuthority,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	accounts := make([]types.AccountMeta, 0, 3)
+	accounts = append(accounts,
+		types.AccountMeta{PubKey: param.Account, IsSigner: false, IsWritable: true},
+		types.AccountMeta{PubKey: param.Authority, IsSigner: true, IsWritable: false},
+	)
+	if param.NewAuthority != nil {
+		accounts = append(accounts, types.AccountMeta{PubKey: *param.NewAuthority, IsSigner: false, IsWritable: false})
+	}
+
+	return types.Instruction{
+		ProgramID: common.BPFLoaderUpgradeableProgramID,
+		Accounts:  accounts,
+		Data:      data,
+	}
+}
+
+type SetAuthorityCheckedParam struct {
+	// Account is a buffer or a program data account
+	Account      common.PublicKey
+	Authority    common.PublicKey
+	NewAuthority common.PublicKey
+}
+
+// SetAuthorityChecked is SetAuthority but the new authority has to sign
+func SetAuthorityChecked(param SetAuthorityCheckedParam) types.Instruction {
+	data, err := bincode.SerializeData(struct {
+		Instruction Instruction
+	}{
+		Instruction: InstructionSetAuthorityChecked,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: common.BPFLoaderUpgradeableProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: param.Account, IsSigner: false, IsWritable: true},
+			{PubKey: param.Authority, IsSigner: true, IsWritable: false},
+			{PubKey: param.NewAuthority, IsSigner: true, IsWritable: false},
+		},
+		Data: data,
+	}
+}
+
+type CloseParam struct {
+	// Account is a buffer, a program data account or an uninitialized account
+	Account   common.PublicKey
+	Recipient common.PublicKey
+	// Authority is not needed for an uninitialized account
+	Authority *common.PublicKey
+	// Program is required when Account is a program data account
+	Program *common.PublicKey
+}
+
+// Close closes an account owned by the loader and sends its lamports to Recipient
+func Close(param CloseParam) types.Instruction {
+	data, err := bincode.SerializeData(struct {
+		Instruction Instruction
+	}{
+		Instruction: InstructionClose,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	accounts := make([]types.AccountMeta, 0, 4)
+	accounts = append(accounts,
+		types.AccountMeta{PubKey: param.Account, IsSigner: false, IsWritable: true},
+		types.AccountMeta{PubKey: param.Recipient, IsSigner: false, IsWritable: true},
+	)
+	if param.Authority != nil {
+		accounts = append(accounts, types.AccountMeta{PubKey: *param.Authority, IsSigner: true, IsWritable: false})
+	}
+	if param.Program != nil {
+		accounts = append(accounts, types.AccountMeta{PubKey: *param.Program, IsSigner: false, IsWritable: true})
+	}
+
+	return types.Instruction{
+		ProgramID: common.BPFLoaderUpgradeableProgramID,
+		Accounts:  accounts,
+		Data:      data,
+	}
+}
+
+type ExtendProgramParam struct {
+	ProgramData common.PublicKey
+	Program     common.PublicKey
+	// Payer funds the extra rent, it can be nil if the program data account already holds enough lamports
+	Payer           *common.PublicKey
+	AdditionalBytes uint32
+}
+
+// ExtendProgram grows a program data account so a larger program can be deployed into it
+func ExtendProgram(param ExtendProgramParam) types.Instruction {
+	data, err := bincode.SerializeData(struct {
+		Instruction     Instruction
+		AdditionalBytes uint32
+	}{
+		Instruction:     InstructionExtendProgram,
+		AdditionalBytes: param.AdditionalBytes,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	accounts := make([]types.AccountMeta, 0, 4)
+	accounts = append(accounts,
+		types.AccountMeta{PubKey: param.ProgramData, IsSigner: false, IsWritable: true},
+		types.AccountMeta{PubKey: param.Program, IsSigner: false, IsWritable: true},
+	)
+	if param.Payer != nil {
+		accounts = append(accounts,
+			types.AccountMeta{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+			types.AccountMeta{PubKey: *param.Payer, IsSigner: true, IsWritable: true},
+		)
+	}
+
+	return types.Instruction{
+		ProgramID: common.BPFLoaderUpgradeableProgramID,
+		Accounts:  accounts,
+		Data:      data,
+	}
+}
@@ -0,0 +1,303 @@
+package bpf_loader_upgradeable
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/qimeila/solana-go-sdk/common"
+	"github.com/qimeila/solana-go-sdk/pkg/pointer"
+	"github.com/qimeila/solana-go-sdk/types"
+)
+
+var (
+	testBuffer      = common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7")
+	testAuthority   = common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ")
+	testProgram     = common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm")
+	testProgramData = common.PublicKeyFromString("27kVX7JpPZ1bsrSckbR76mV6GeRqtrjoddubfg2zBpHZ")
+)
+
+func TestInitializeBuffer(t *testing.T) {
+	got := InitializeBuffer(InitializeBufferParam{
+		Buffer:    testBuffer,
+		Authority: testAuthority,
+	})
+	want := types.Instruction{
+		ProgramID: common.BPFLoaderUpgradeableProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: testBuffer, IsSigner: false, IsWritable: true},
+			{PubKey: testAuthority, IsSigner: false, IsWritable: false},
+		},
+		Data: []byte{0, 0, 0, 0},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("InitializeBuffer() = %v, want %v", got, want)
+	}
+}
+
+func TestWrite(t *testing.T) {
+	type args struct {
+		param WriteParam
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Instruction
+	}{
+		{
+			args: args{
+				param: WriteParam{
+					Buffer:    testBuffer,
+					Authority: testAuthority,
+					Offset:    1024,
+					Bytes:     []byte{1, 2, 3},
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.BPFLoaderUpgradeableProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: testBuffer, IsSigner: false, IsWritable: true},
+					{PubKey: testAuthority, IsSigner: true, IsWritable: false},
+				},
+				Data: []byte{1, 0, 0, 0, 0, 4, 0, 0, 3, 0, 0, 0, 0, 0, 0, 0, 1, 2, 3},
+			},
+		},
+		{
+			args: args{
+				param: WriteParam{
+					Buffer:    testBuffer,
+					Authority: testAuthority,
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.BPFLoaderUpgradeableProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: testBuffer, IsSigner: false, IsWritable: true},
+					{PubKey: testAuthority, IsSigner: true, IsWritable: false},
+				},
+				Data: []byte{1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Write(tt.args.param); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Write() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDeployWithMaxDataLen(t *testing.T) {
+	payer := common.PublicKeyFromString("11111111111111111111111111111112")
+	got := DeployWithMaxDataLen(DeployWithMaxDataLenParam{
+		Payer:       payer,
+		ProgramData: testProgramData,
+		Program:     testProgram,
+		Buffer:      testBuffer,
+		Authority:   testAuthority,
+		MaxDataLen:  200000,
+	})
+	want := types.Instruction{
+		ProgramID: common.BPFLoaderUpgradeableProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: payer, IsSigner: true, IsWritable: true},
+			{PubKey: testProgramData, IsSigner: false, IsWritable: true},
+			{PubKey: testProgram, IsSigner: false, IsWritable: true},
+			{PubKey: testBuffer, IsSigner: false, IsWritable: true},
+			{PubKey: common.SysVarRentPubkey, IsSigner: false, IsWritable: false},
+			{PubKey: common.SysVarClockPubkey, IsSigner: false, IsWritable: false},
+			{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: testAuthority, IsSigner: true, IsWritable: false},
+		},
+		Data: []byte{2, 0, 0, 0, 64, 13, 3, 0, 0, 0, 0, 0},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DeployWithMaxDataLen() = %v, want %v", got, want)
+	}
+}
+
+func TestUpgrade(t *testing.T) {
+	spill := common.PublicKeyFromString("11111111111111111111111111111112")
+	got := Upgrade(UpgradeParam{
+		ProgramData: testProgramData,
+		Program:     testProgram,
+		Buffer:      testBuffer,
+		Spill:       spill,
+		Authority:   testAuthority,
+	})
+	want := types.Instruction{
+		ProgramID: common.BPFLoaderUpgradeableProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: testProgramData, IsSigner: false, IsWritable: true},
+			{PubKey: testProgram, IsSigner: false, IsWritable: true},
+			{PubKey: testBuffer, IsSigner: false, IsWritable: true},
+			{PubKey: spill, IsSigner: false, IsWritable: true},
+			{PubKey: common.SysVarRentPubkey, IsSigner: false, IsWritable: false},
+			{PubKey: common.SysVarClockPubkey, IsSigner: false, IsWritable: false},
+			{PubKey: testAuthority, IsSigner: true, IsWritable: false},
+		},
+		Data: []byte{3, 0, 0, 0},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Upgrade() = %v, want %v", got, want)
+	}
+}
+
+func TestSetAuthority(t *testing.T) {
+	newAuthority := common.PublicKeyFromString("11111111111111111111111111111112")
+	type args struct {
+		param SetAuthorityParam
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Instruction
+	}{
+		{
+			args: args{
+				param: SetAuthorityParam{
+					Account:      testProgramData,
+					Authority:    testAuthority,
+					NewAuthority: &newAuthority,
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.BPFLoaderUpgradeableProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: testProgramData, IsSigner: false, IsWritable: true},
+					{PubKey: testAuthority, IsSigner: true, IsWritable: false},
+					{PubKey: newAuthority, IsSigner: false, IsWritable: false},
+				},
+				Data: []byte{4, 0, 0, 0},
+			},
+		},
+		{
+			name: "immutable",
+			args: args{
+				param: SetAuthorityParam{
+					Account:   testProgramData,
+					Authority: testAuthority,
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.BPFLoaderUpgradeableProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: testProgramData, IsSigner: false, IsWritable: true},
+					{PubKey: testAuthority, IsSigner: true, IsWritable: false},
+				},
+				Data: []byte{4, 0, 0, 0},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SetAuthority(tt.args.param); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SetAuthority() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetAuthorityChecked(t *testing.T) {
+	newAuthority := common.PublicKeyFromString("11111111111111111111111111111112")
+	got := SetAuthorityChecked(SetAuthorityCheckedParam{
+		Account:      testBuffer,
+		Authority:    testAuthority,
+		NewAuthority: newAuthority,
+	})
+	want := types.Instruction{
+		ProgramID: common.BPFLoaderUpgradeableProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: testBuffer, IsSigner: false, IsWritable: true},
+			{PubKey: testAuthority, IsSigner: true, IsWritable: false},
+			{PubKey: newAuthority, IsSigner: true, IsWritable: false},
+		},
+		Data: []byte{7, 0, 0, 0},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("SetAuthorityChecked() = %v, want %v", got, want)
+	}
+}
+
+func TestClose(t *testing.T) {
+	recipient := common.PublicKeyFromString("11111111111111111111111111111112")
+	type args struct {
+		param CloseParam
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Instruction
+	}{
+		{
+			name: "buffer",
+			args: args{
+				param: CloseParam{
+					Account:   testBuffer,
+					Recipient: recipient,
+					Authority: pointer.Get[common.PublicKey](testAuthority),
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.BPFLoaderUpgradeableProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: testBuffer, IsSigner: false, IsWritable: true},
+					{PubKey: recipient, IsSigner: false, IsWritable: true},
+					{PubKey: testAuthority, IsSigner: true, IsWritable: false},
+				},
+				Data: []byte{5, 0, 0, 0},
+			},
+		},
+		{
+			name: "program data",
+			args: args{
+				param: CloseParam{
+					Account:   testProgramData,
+					Recipient: recipient,
+					Authority: pointer.Get[common.PublicKey](testAuthority),
+					Program:   pointer.Get[common.PublicKey](testProgram),
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.BPFLoaderUpgradeableProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: testProgramData, IsSigner: false, IsWritable: true},
+					{PubKey: recipient, IsSigner: false, IsWritable: true},
+					{PubKey: testAuthority, IsSigner: true, IsWritable: false},
+					{PubKey: testProgram, IsSigner: false, IsWritable: true},
+				},
+				Data: []byte{5, 0, 0, 0},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Close(tt.args.param); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Close() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExtendProgram(t *testing.T) {
+	payer := common.PublicKeyFromString("11111111111111111111111111111112")
+	got := ExtendProgram(ExtendProgramParam{
+		ProgramData:     testProgramData,
+		Program:         testProgram,
+		Payer:           &payer,
+		AdditionalBytes: 10240,
+	})
+	want := types.Instruction{
+		ProgramID: common.BPFLoaderUpgradeableProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: testProgramData, IsSigner: false, IsWritable: true},
+			{PubKey: testProgram, IsSigner: false, IsWritable: true},
+			{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: payer, IsSigner: true, IsWritable: true},
+		},
+		Data: []byte{6, 0, 0, 0, 0, 40, 0, 0},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ExtendProgram() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,125 @@
+package bpf_loader_upgradeable
+
+import (
+	"encoding/binary"
+
+	"github.com/qimeila/solana-go-sdk/common"
+)
+
+const (
+	// BufferMetadataSize is the size of a buffer account without the program
+	BufferMetadataSize = 37
+	// ProgramSize is the size of a program account
+	ProgramSize = 36
+	// ProgramDataMetadataSize is the size of a program data account without the program
+	ProgramDataMetadataSize = 45
+)
+
+// BufferSize is the space a buffer needs to hold a program of programLen bytes
+func BufferSize(programLen uint64) uint64 {
+	return BufferMetadataSize + programLen
+}
+
+// ProgramDataSize is the space a program data account needs to hold a program of maxDataLen bytes
+func ProgramDataSize(maxDataLen uint64) uint64 {
+	return ProgramDataMetadataSize + maxDataLen
+}
+
+// GetProgramDataAddress derives the program data account of a program
+func GetProgramDataAddress(program common.PublicKey) (common.PublicKey, uint8, error) {
+	return common.FindProgramAddress([][]byte{program.Bytes()}, common.BPFLoaderUpgradeableProgramID)
+}
+
+type UpgradeableLoaderStateType uint32
+
+const (
+	UpgradeableLoaderStateUninitialized UpgradeableLoaderStateType = iota
+	UpgradeableLoaderStateBuffer
+	UpgradeableLoaderStateProgram
+	UpgradeableLoaderStateProgramData
+)
+
+type UpgradeableLoaderState struct {
+	Type UpgradeableLoaderStateType
+	// Authority is the buffer's authority or the program's upgrade authority, nil means the program is immutable
+	Authority *common.PublicKey
+	// ProgramDataAddress is only set for Program
+	ProgramDataAddress common.PublicKey
+	// Slot is the slot the program was last deployed at, it is only set for ProgramData
+	Slot uint64
+	// Data is the program in a Buffer or ProgramData
+	Data []byte
+}
+
+func UpgradeableLoaderStateFromData(data []byte) (UpgradeableLoaderState, error) {
+	if len(data) < 4 {
+		return UpgradeableLoaderState{}, ErrInvalidAccountDataSize
+	}
+
+	current := 0
+	stateType := UpgradeableLoaderStateType(binary.LittleEndian.Uint32(data[current : current+4]))
+	current += 4
+
+	switch stateType {
+	case UpgradeableLoaderStateUninitialized:
+		return UpgradeableLoaderState{Type: stateType}, nil
+	case UpgradeableLoaderStateBuffer:
+		if len(data) < BufferMetadataSize {
+			return UpgradeableLoaderState{}, ErrInvalidAccountDataSize
+		}
+		authority, err := optionPubkeyFromData(data[current:])
+		if err != nil {
+			return UpgradeableLoaderState{}, err
+		}
+		return UpgradeableLoaderState{
+			Type:      stateType,
+			Authority: authority,
+			Data:      data[BufferMetadataSize:],
+		}, nil
+	case UpgradeableLoaderStateProgram:
+		if len(data) < ProgramSize {
+			return UpgradeableLoaderState{}, ErrInvalidAccountDataSize
+		}
+		return UpgradeableLoaderState{
+			Type:               stateType,
+			ProgramDataAddress: common.PublicKeyFromBytes(data[current : current+32]),
+		}, nil
+	case UpgradeableLoaderStateProgramData:
+		if len(data) < ProgramDataMetadataSize {
+			return UpgradeableLoaderState{}, ErrInvalidAccountDataSize
+		}
+		slot := binary.LittleEndian.Uint64(data[current : current+8])
+		current += 8
+		authority, err := optionPubkeyFromData(data[current:])
+		if err != nil {
+			return UpgradeableLoaderState{}, err
+		}
+		return UpgradeableLoaderState{
+			Type:      stateType,
+			Authority: authority,
+			Slot:      slot,
+			Data:      data[ProgramDataMetadataSize:],
+		}, nil
+	}
+
+	return UpgradeableLoaderState{}, ErrInvalidAccountData
+}
+
+func DeserializeUpgradeableLoaderState(data []byte, accountOwner common.PublicKey) (UpgradeableLoaderState, error) {
+	if accountOwner != common.BPFLoaderUpgradeableProgramID {
+		return UpgradeableLoaderState{}, ErrInvalidAccountOwner
+	}
+	return UpgradeableLoaderStateFromData(data)
+}
+
+// optionPubkeyFromData reads an Option<Pubkey> from a fixed 33 bytes slot
+func optionPubkeyFromData(data []byte) (*common.PublicKey, error) {
+	switch data[0] {
+	case 0:
+		return nil, nil
+	case 1:
+		pubkey := common.PublicKeyFromBytes(data[1:33])
+		return &pubkey, nil
+	}
+	return nil, ErrInvalidAccountData
+}
@@ -0,0 +1,117 @@
+package bpf_loader_upgradeable
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/qimeila/solana-go-sdk/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeserializeUpgradeableLoaderState(t *testing.T) {
+	authority := common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ")
+	programData := common.PublicKeyFromString("27kVX7JpPZ1bsrSckbR76mV6GeRqtrjoddubfg2zBpHZ")
+
+	buffer := make([]byte, BufferSize(3))
+	binary.LittleEndian.PutUint32(buffer, uint32(UpgradeableLoaderStateBuffer))
+	buffer[4] = 1
+	copy(buffer[5:], authority.Bytes())
+	copy(buffer[BufferMetadataSize:], []byte{1, 2, 3})
+
+	program := make([]byte, ProgramSize)
+	binary.LittleEndian.PutUint32(program, uint32(UpgradeableLoaderStateProgram))
+	copy(program[4:], programData.Bytes())
+
+	immutableProgramData := make([]byte, ProgramDataSize(2))
+	binary.LittleEndian.PutUint32(immutableProgramData, uint32(UpgradeableLoaderStateProgramData))
+	binary.LittleEndian.PutUint64(immutableProgramData[4:], 300000000)
+	copy(immutableProgramData[ProgramDataMetadataSize:], []byte{0x7f, 0x45})
+
+	type args struct {
+		data         []byte
+		accountOwner common.PublicKey
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    UpgradeableLoaderState
+		wantErr error
+	}{
+		{
+			name: "invalid owner",
+			args: args{
+				data:         buffer,
+				accountOwner: common.BPFLoaderProgramID,
+			},
+			want:    UpgradeableLoaderState{},
+			wantErr: ErrInvalidAccountOwner,
+		},
+		{
+			name: "buffer",
+			args: args{
+				data:         buffer,
+				accountOwner: common.BPFLoaderUpgradeableProgramID,
+			},
+			want: UpgradeableLoaderState{
+				Type:      UpgradeableLoaderStateBuffer,
+				Authority: &authority,
+				Data:      []byte{1, 2, 3},
+			},
+		},
+		{
+			name: "program",
+			args: args{
+				data:         program,
+				accountOwner: common.BPFLoaderUpgradeableProgramID,
+			},
+			want: UpgradeableLoaderState{
+				Type:               UpgradeableLoaderStateProgram,
+				ProgramDataAddress: programData,
+			},
+		},
+		{
+			name: "immutable program data",
+			args: args{
+				data:         immutableProgramData,
+				accountOwner: common.BPFLoaderUpgradeableProgramID,
+			},
+			want: UpgradeableLoaderState{
+				Type: UpgradeableLoaderStateProgramData,
+				Slot: 300000000,
+				Data: []byte{0x7f, 0x45},
+			},
+		},
+		{
+			name: "short program data",
+			args: args{
+				data:         immutableProgramData[:20],
+				accountOwner: common.BPFLoaderUpgradeableProgramID,
+			},
+			want:    UpgradeableLoaderState{},
+			wantErr: ErrInvalidAccountDataSize,
+		},
+		{
+			name: "unknown type",
+			args: args{
+				data:         []byte{4, 0, 0, 0},
+				accountOwner: common.BPFLoaderUpgradeableProgramID,
+			},
+			want:    UpgradeableLoaderState{},
+			wantErr: ErrInvalidAccountData,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DeserializeUpgradeableLoaderState(tt.args.data, tt.args.accountOwner)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGetProgramDataAddress(t *testing.T) {
+	// the program data account of the metaplex token metadata program
+	got, _, err := GetProgramDataAddress(common.PublicKeyFromString("metaqbxxUerdq28cj1RbAWkYQm3ybzjb6a8bt518x1s"))
+	assert.Nil(t, err)
+	assert.Equal(t, common.PublicKeyFromString("PwDiXFxQsGra4sFFTT8r1QWRMd4vfumiWC1jfWNfdYT"), got)
+}
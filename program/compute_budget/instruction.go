@@ -1,8 +1,8 @@
 package compute_budget
 
 import (
-	"github.com/near/borsh-go"
 	"github.com/qimeila/solana-go-sdk/common"
+	"github.com/qimeila/solana-go-sdk/pkg/borsh"
 	"github.com/qimeila/solana-go-sdk/types"
 )
 
@@ -0,0 +1,12 @@
+package ed25519
+
+import "errors"
+
+var (
+	ErrInvalidInstructionDataSize = errors.New("invalid instruction data size")
+	ErrInvalidDataOffsets         = errors.New("invalid data offsets")
+	ErrInvalidPublicKey           = errors.New("invalid public key")
+	ErrInvalidSignature           = errors.New("invalid signature")
+	ErrTooManySignatures          = errors.New("too many signatures")
+	ErrInstructionDataTooLarge    = errors.New("instruction data too large")
+)
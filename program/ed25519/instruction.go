@@ -0,0 +1,108 @@
+package ed25519
+
+import (
+	"crypto/ed25519"
+	"fmt"
+	"math"
+
+	"github.com/qimeila/solana-go-sdk/common"
+	"github.com/qimeila/solana-go-sdk/internal/precompile"
+	"github.com/qimeila/solana-go-sdk/pkg/bincode"
+	"github.com/qimeila/solana-go-sdk/types"
+)
+
+const (
+	PublicKeySize                  = ed25519.PublicKeySize
+	SignatureSize                  = ed25519.SignatureSize
+	SignatureOffsetsSerializedSize = precompile.OffsetsSerializedSize
+	SignatureOffsetsStart          = precompile.SignatureOffsetsStart
+
+	// CurrentInstructionIndex makes an offset point into the ed25519 instruction itself
+	CurrentInstructionIndex = precompile.CurrentInstructionIndex
+)
+
+// Ed25519SignatureOffsets locates a signature, a public key and a message.
+// Each one lives in the data of the instruction at its instruction index of the same transaction.
+type Ed25519SignatureOffsets struct {
+	SignatureOffset           uint16
+	SignatureInstructionIndex uint16
+	PublicKeyOffset           uint16
+	PublicKeyInstructionIndex uint16
+	MessageDataOffset         uint16
+	MessageDataSize           uint16
+	MessageInstructionIndex   uint16
+}
+
+// DataStart returns where the data following the offsets begins in an instruction with n signatures
+func DataStart(n int) int {
+	return SignatureOffsetsStart + n*SignatureOffsetsSerializedSize
+}
+
+type Ed25519SignatureParam struct {
+	PublicKey []byte
+	Signature []byte
+	Message   []byte
+}
+
+// NewEd25519Instruction builds an instruction which carries every public key, signature and message itself.
+func NewEd25519Instruction(params []Ed25519SignatureParam) (types.Instruction, error) {
+	offsets := make([]Ed25519SignatureOffsets, 0, len(params))
+	data := []byte{}
+	dataStart := DataStart(len(params))
+	for i, param := range params {
+		if len(param.PublicKey) != PublicKeySize {
+			return types.Instruction{}, fmt.Errorf("signature %d: %w", i, ErrInvalidPublicKey)
+		}
+		if len(param.Signature) != SignatureSize {
+			return types.Instruction{}, fmt.Errorf("signature %d: %w", i, ErrInvalidSignature)
+		}
+
+		publicKeyOffset := dataStart + len(data)
+		data = append(data, param.PublicKey...)
+		signatureOffset := dataStart + len(data)
+		data = append(data, param.Signature...)
+		messageOffset := dataStart + len(data)
+		data = append(data, param.Message...)
+
+		if dataStart+len(data) > math.MaxUint16 {
+			return types.Instruction{}, ErrInstructionDataTooLarge
+		}
+
+		offsets = append(offsets, Ed25519SignatureOffsets{
+			SignatureOffset:           uint16(signatureOffset),
+			SignatureInstructionIndex: CurrentInstructionIndex,
+			PublicKeyOffset:           uint16(publicKeyOffset),
+			PublicKeyInstructionIndex: CurrentInstructionIndex,
+			MessageDataOffset:         uint16(messageOffset),
+			MessageDataSize:           uint16(len(param.Message)),
+			MessageInstructionIndex:   CurrentInstructionIndex,
+		})
+	}
+
+	return NewEd25519InstructionWithOffsets(offsets, data)
+}
+
+// NewEd25519InstructionWithOffsets builds an instruction from raw offsets, data is placed right after them.
+// Offsets can point into other instructions of the transaction by their index.
+// Offsets with CurrentInstructionIndex are relative to the start of this instruction's data, use DataStart to find where data lands.
+func NewEd25519InstructionWithOffsets(offsets []Ed25519SignatureOffsets, data []byte) (types.Instruction, error) {
+	if len(offsets) > math.MaxUint8 {
+		return types.Instruction{}, ErrTooManySignatures
+	}
+
+	instrData := make([]byte, 0, DataStart(len(offsets))+len(data))
+	instrData = append(instrData, uint8(len(offsets)), 0) // count of offsets, padding
+	for _, o := range offsets {
+		b, err := bincode.SerializeData(o)
+		if err != nil {
+			return types.Instruction{}, err
+		}
+		instrData = append(instrData, b...)
+	}
+	instrData = append(instrData, data...)
+
+	return types.Instruction{
+		ProgramID: common.Ed25519ProgramID,
+		Data:      instrData,
+	}, nil
+}
@@ -0,0 +1,267 @@
+package ed25519
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"testing"
+
+	"filippo.io/edwards25519"
+	"github.com/qimeila/solana-go-sdk/common"
+	"github.com/qimeila/solana-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func testKey(b byte) ed25519.PrivateKey {
+	seed := make([]byte, ed25519.SeedSize)
+	for i := range seed {
+		seed[i] = b
+	}
+	return ed25519.NewKeyFromSeed(seed)
+}
+
+func TestNewEd25519Instruction(t *testing.T) {
+	key := testKey(1)
+	message := []byte("message")
+	signature := ed25519.Sign(key, message)
+
+	instr, err := NewEd25519Instruction([]Ed25519SignatureParam{
+		{PublicKey: key.Public().(ed25519.PublicKey), Signature: signature, Message: message},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, common.Ed25519ProgramID, instr.ProgramID)
+	assert.Empty(t, instr.Accounts)
+
+	want := []byte{
+		1, 0,
+		0x30, 0, 0xff, 0xff, // signature
+		0x10, 0, 0xff, 0xff, // public key
+		0x70, 0, 7, 0, 0xff, 0xff, // message
+	}
+	want = append(want, key.Public().(ed25519.PublicKey)...)
+	want = append(want, signature...)
+	want = append(want, message...)
+	assert.Equal(t, want, instr.Data)
+}
+
+func TestNewEd25519InstructionInvalidParam(t *testing.T) {
+	key := testKey(1)
+	signature := ed25519.Sign(key, []byte("message"))
+
+	tests := []struct {
+		name    string
+		param   Ed25519SignatureParam
+		wantErr error
+	}{
+		{
+			name:    "short public key",
+			param:   Ed25519SignatureParam{PublicKey: []byte{1, 2, 3}, Signature: signature},
+			wantErr: ErrInvalidPublicKey,
+		},
+		{
+			name:    "short signature",
+			param:   Ed25519SignatureParam{PublicKey: key.Public().(ed25519.PublicKey), Signature: signature[:63]},
+			wantErr: ErrInvalidSignature,
+		},
+		{
+			name: "too large",
+			param: Ed25519SignatureParam{
+				PublicKey: key.Public().(ed25519.PublicKey),
+				Signature: signature,
+				Message:   make([]byte, 65536),
+			},
+			wantErr: ErrInstructionDataTooLarge,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewEd25519Instruction([]Ed25519SignatureParam{tt.param})
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestParseEd25519Instruction(t *testing.T) {
+	key1, key2 := testKey(1), testKey(2)
+	instr, err := NewEd25519Instruction([]Ed25519SignatureParam{
+		{PublicKey: key1.Public().(ed25519.PublicKey), Signature: ed25519.Sign(key1, []byte("a")), Message: []byte("a")},
+		{PublicKey: key2.Public().(ed25519.PublicKey), Signature: ed25519.Sign(key2, []byte("bc")), Message: []byte("bc")},
+	})
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		data    []byte
+		want    []Ed25519SignatureOffsets
+		wantErr error
+	}{
+		{
+			name: "two signatures",
+			data: instr.Data,
+			want: []Ed25519SignatureOffsets{
+				{
+					SignatureOffset:           62,
+					SignatureInstructionIndex: CurrentInstructionIndex,
+					PublicKeyOffset:           30,
+					PublicKeyInstructionIndex: CurrentInstructionIndex,
+					MessageDataOffset:         126,
+					MessageDataSize:           1,
+					MessageInstructionIndex:   CurrentInstructionIndex,
+				},
+				{
+					SignatureOffset:           159,
+					SignatureInstructionIndex: CurrentInstructionIndex,
+					PublicKeyOffset:           127,
+					PublicKeyInstructionIndex: CurrentInstructionIndex,
+					MessageDataOffset:         223,
+					MessageDataSize:           2,
+					MessageInstructionIndex:   CurrentInstructionIndex,
+				},
+			},
+		},
+		{
+			name: "no signatures",
+			data: []byte{0, 0},
+			want: []Ed25519SignatureOffsets{},
+		},
+		{
+			name:    "no signatures with trailing data",
+			data:    []byte{0, 0, 1},
+			wantErr: ErrInvalidInstructionDataSize,
+		},
+		{
+			name:    "empty",
+			data:    []byte{},
+			wantErr: ErrInvalidInstructionDataSize,
+		},
+		{
+			name:    "truncated offsets",
+			data:    instr.Data[:DataStart(2)-1],
+			wantErr: ErrInvalidInstructionDataSize,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseEd25519Instruction(tt.data)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestVerifyEd25519Instruction(t *testing.T) {
+	key1, key2 := testKey(1), testKey(2)
+	order := []byte("order: buy 10 @ 1.5")
+	orderSignature := ed25519.Sign(key1, order)
+
+	memo := types.Instruction{ProgramID: common.MemoProgramID, Data: order}
+
+	multi, err := NewEd25519Instruction([]Ed25519SignatureParam{
+		{PublicKey: key1.Public().(ed25519.PublicKey), Signature: orderSignature, Message: order},
+		{PublicKey: key2.Public().(ed25519.PublicKey), Signature: ed25519.Sign(key2, []byte("b")), Message: []byte("b")},
+	})
+	assert.NoError(t, err)
+
+	// public key and signature are carried by the ed25519 instruction, the message is the memo at index 0
+	dataStart := DataStart(1)
+	crossInstruction := func(messageInstructionIndex uint16, messageSize uint16) types.Instruction {
+		instr, err := NewEd25519InstructionWithOffsets(
+			[]Ed25519SignatureOffsets{
+				{
+					PublicKeyOffset:           uint16(dataStart),
+					PublicKeyInstructionIndex: CurrentInstructionIndex,
+					SignatureOffset:           uint16(dataStart + PublicKeySize),
+					SignatureInstructionIndex: CurrentInstructionIndex,
+					MessageDataOffset:         0,
+					MessageDataSize:           messageSize,
+					MessageInstructionIndex:   messageInstructionIndex,
+				},
+			},
+			append(append([]byte{}, key1.Public().(ed25519.PublicKey)...), orderSignature...),
+		)
+		assert.NoError(t, err)
+		return instr
+	}
+
+	tampered, err := NewEd25519Instruction([]Ed25519SignatureParam{
+		{PublicKey: key2.Public().(ed25519.PublicKey), Signature: orderSignature, Message: order},
+	})
+	assert.NoError(t, err)
+
+	// the identity point makes [S]B = R + [k]A hold for any message, crypto/ed25519.Verify accepts it but verify_strict does not
+	r, err := edwards25519.NewScalar().SetUniformBytes(bytes.Repeat([]byte{7}, 64))
+	assert.NoError(t, err)
+	weakSignature := append(new(edwards25519.Point).ScalarBaseMult(r).Bytes(), r.Bytes()...)
+	identity := edwards25519.NewIdentityPoint().Bytes()
+	nonCanonicalIdentity := append([]byte{0xee}, bytes.Repeat([]byte{0xff}, 30)...)
+	nonCanonicalIdentity = append(nonCanonicalIdentity, 0x7f)
+	for _, publicKey := range [][]byte{identity, nonCanonicalIdentity} {
+		assert.True(t, ed25519.Verify(publicKey, order, weakSignature))
+	}
+	weakInstruction := func(publicKey []byte) types.Instruction {
+		instr, err := NewEd25519Instruction([]Ed25519SignatureParam{
+			{PublicKey: publicKey, Signature: weakSignature, Message: order},
+		})
+		assert.NoError(t, err)
+		return instr
+	}
+
+	tests := []struct {
+		name         string
+		instructions []types.Instruction
+		index        int
+		wantErr      error
+	}{
+		{
+			name:         "multiple signatures",
+			instructions: []types.Instruction{multi},
+			index:        0,
+		},
+		{
+			name:         "message in another instruction",
+			instructions: []types.Instruction{memo, crossInstruction(0, uint16(len(order)))},
+			index:        1,
+		},
+		{
+			name:         "message instruction out of range",
+			instructions: []types.Instruction{memo, crossInstruction(2, uint16(len(order)))},
+			index:        1,
+			wantErr:      ErrInvalidDataOffsets,
+		},
+		{
+			name:         "message out of bounds",
+			instructions: []types.Instruction{memo, crossInstruction(0, uint16(len(order)+1))},
+			index:        1,
+			wantErr:      ErrInvalidDataOffsets,
+		},
+		{
+			name:         "wrong message",
+			instructions: []types.Instruction{memo, crossInstruction(0, uint16(len(order)-1))},
+			index:        1,
+			wantErr:      ErrInvalidSignature,
+		},
+		{
+			name:         "wrong signer",
+			instructions: []types.Instruction{tampered},
+			index:        0,
+			wantErr:      ErrInvalidSignature,
+		},
+		{
+			name:         "small order public key",
+			instructions: []types.Instruction{weakInstruction(identity)},
+			index:        0,
+			wantErr:      ErrInvalidSignature,
+		},
+		{
+			name:         "non-canonical public key",
+			instructions: []types.Instruction{weakInstruction(nonCanonicalIdentity)},
+			index:        0,
+			wantErr:      ErrInvalidSignature,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := VerifyEd25519Instruction(tt.instructions, tt.index)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package ed25519
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"fmt"
+
+	"filippo.io/edwards25519"
+	"github.com/qimeila/solana-go-sdk/internal/precompile"
+	"github.com/qimeila/solana-go-sdk/types"
+)
+
+// ParseEd25519Instruction reads the signature offsets out of an ed25519 instruction's data
+func ParseEd25519Instruction(data []byte) ([]Ed25519SignatureOffsets, error) {
+	if len(data) < SignatureOffsetsStart {
+		return nil, ErrInvalidInstructionDataSize
+	}
+	n := int(data[0])
+	if n == 0 && len(data) > SignatureOffsetsStart {
+		return nil, ErrInvalidInstructionDataSize
+	}
+	parsed, ok := precompile.ParseOffsets(data, n)
+	if !ok {
+		return nil, ErrInvalidInstructionDataSize
+	}
+
+	offsets := make([]Ed25519SignatureOffsets, 0, n)
+	for _, o := range parsed {
+		offsets = append(offsets, Ed25519SignatureOffsets(o))
+	}
+	return offsets, nil
+}
+
+// VerifyEd25519Instruction checks the ed25519 instruction at index of instructions the same way the precompile does,
+// so a transaction which would fail on chain can be caught before it is sent.
+func VerifyEd25519Instruction(instructions []types.Instruction, index int) error {
+	if index < 0 || index >= len(instructions) {
+		return fmt.Errorf("instruction index %d out of range", index)
+	}
+	data := instructions[index].Data
+	offsets, err := ParseEd25519Instruction(data)
+	if err != nil {
+		return err
+	}
+
+	for i, o := range offsets {
+		signature, err := getDataSlice(data, instructions, o.SignatureInstructionIndex, o.SignatureOffset, SignatureSize)
+		if err != nil {
+			return fmt.Errorf("signature %d: %w", i, err)
+		}
+		publicKey, err := getDataSlice(data, instructions, o.PublicKeyInstructionIndex, o.PublicKeyOffset, PublicKeySize)
+		if err != nil {
+			return fmt.Errorf("signature %d: %w", i, err)
+		}
+		message, err := getDataSlice(data, instructions, o.MessageInstructionIndex, o.MessageDataOffset, int(o.MessageDataSize))
+		if err != nil {
+			return fmt.Errorf("signature %d: %w", i, err)
+		}
+		if !verifyStrict(publicKey, message, signature) {
+			return fmt.Errorf("signature %d: %w", i, ErrInvalidSignature)
+		}
+	}
+	return nil
+}
+
+// verifyStrict mirrors ed25519-dalek's verify_strict which the precompile uses.
+// On top of crypto/ed25519.Verify it rejects non-canonical encodings and small order points for A and R.
+func verifyStrict(publicKey, message, signature []byte) bool {
+	if !isStrictPoint(publicKey) || !isStrictPoint(signature[:32]) {
+		return false
+	}
+	return ed25519.Verify(publicKey, message, signature)
+}
+
+func isStrictPoint(b []byte) bool {
+	p, err := new(edwards25519.Point).SetBytes(b)
+	if err != nil || !bytes.Equal(p.Bytes(), b) {
+		return false
+	}
+	return new(edwards25519.Point).MultByCofactor(p).Equal(edwards25519.NewIdentityPoint()) == 0
+}
+
+func getDataSlice(data []byte, instructions []types.Instruction, instructionIndex uint16, offset uint16, size int) ([]byte, error) {
+	b, ok := precompile.GetDataSlice(data, instructions, instructionIndex, offset, size)
+	if !ok {
+		return nil, ErrInvalidDataOffsets
+	}
+	return b, nil
+}
@@ -0,0 +1,585 @@
+package bubblegum
+
+import (
+	"crypto/sha256"
+
+	"github.com/qimeila/solana-go-sdk/common"
+	"github.com/qimeila/solana-go-sdk/pkg/borsh"
+	"github.com/qimeila/solana-go-sdk/types"
+)
+
+// Instruction is the anchor discriminator, the first 8 bytes of sha256("global:<instruction name>")
+type Instruction [8]byte
+
+var (
+	InstructionCreateTree             = newInstruction("create_tree")
+	InstructionMintV1                 = newInstruction("mint_v1")
+	InstructionMintToCollectionV1     = newInstruction("mint_to_collection_v1")
+	InstructionTransfer               = newInstruction("transfer")
+	InstructionBurn                   = newInstruction("burn")
+	InstructionDelegate               = newInstruction("delegate")
+	InstructionRedeem                 = newInstruction("redeem")
+	InstructionCancelRedeem           = newInstruction("cancel_redeem")
+	InstructionVerifyCreator          = newInstruction("verify_creator")
+	InstructionUnverifyCreator        = newInstruction("unverify_creator")
+	InstructionVerifyCollection       = newInstruction("verify_collection")
+	InstructionSetAndVerifyCollection = newInstruction("set_and_verify_collection")
+	InstructionDecompressV1           = newInstruction("decompress_v1")
+)
+
+func newInstruction(name string) Instruction {
+	h := sha256.Sum256([]byte("global:" + name))
+	var i Instruction
+	copy(i[:], h[:8])
+	return i
+}
+
+type CreateTreeParam struct {
+	TreeConfig    common.PublicKey
+	MerkleTree    common.PublicKey
+	Payer         common.PublicKey
+	TreeCreator   common.PublicKey
+	MaxDepth      uint32
+	MaxBufferSize uint32
+	Public        *bool
+}
+
+// CreateTree initializes a merkle tree account which has already been allocated and assigned to the account compression program
+func CreateTree(param CreateTreeParam) types.Instruction {
+	data, err := borsh.Serialize(struct {
+		Instruction   Instruction
+		MaxDepth      uint32
+		MaxBufferSize uint32
+		Public        *bool
+	}{
+		Instruction:   InstructionCreateTree,
+		MaxDepth:      param.MaxDepth,
+		MaxBufferSize: param.MaxBufferSize,
+		Public:        param.Public,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: common.MetaplexBubblegumProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: param.TreeConfig, IsSigner: false, IsWritable: true},
+			{PubKey: param.MerkleTree, IsSigner: false, IsWritable: true},
+			{PubKey: param.Payer, IsSigner: true, IsWritable: true},
+			{PubKey: param.TreeCreator, IsSigner: true, IsWritable: false},
+			{PubKey: common.SPLNoopProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: common.SPLAccountCompressionProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+		},
+		Data: data,
+	}
+}
+
+type MintV1Param struct {
+	TreeConfig            common.PublicKey
+	LeafOwner             common.PublicKey
+	LeafDelegate          common.PublicKey
+	MerkleTree            common.PublicKey
+	Payer                 common.PublicKey
+	TreeCreatorOrDelegate common.PublicKey
+	Metadata              MetadataArgs
+}
+
+func MintV1(param MintV1Param) types.Instruction {
+	data, err := borsh.Serialize(struct {
+		Instruction Instruction
+		Metadata    MetadataArgs
+	}{
+		Instruction: InstructionMintV1,
+		Metadata:    param.Metadata,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: common.MetaplexBubblegumProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: param.TreeConfig, IsSigner: false, IsWritable: true},
+			{PubKey: param.LeafOwner, IsSigner: false, IsWritable: false},
+			{PubKey: param.LeafDelegate, IsSigner: false, IsWritable: false},
+			{PubKey: param.MerkleTree, IsSigner: false, IsWritable: true},
+			{PubKey: param.Payer, IsSigner: true, IsWritable: false},
+			{PubKey: param.TreeCreatorOrDelegate, IsSigner: true, IsWritable: false},
+			{PubKey: common.SPLNoopProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: common.SPLAccountCompressionProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+		},
+		Data: data,
+	}
+}
+
+type MintToCollectionV1Param struct {
+	TreeConfig            common.PublicKey
+	LeafOwner             common.PublicKey
+	LeafDelegate          common.PublicKey
+	MerkleTree            common.PublicKey
+	Payer                 common.PublicKey
+	TreeCreatorOrDelegate common.PublicKey
+	CollectionAuthority   common.PublicKey
+	// CollectionAuthorityRecord is only needed when the collection authority is a delegate
+	CollectionAuthorityRecord *common.PublicKey
+	CollectionMint            common.PublicKey
+	CollectionMetadata        common.PublicKey
+	CollectionEdition         common.PublicKey
+	BubblegumSigner           common.PublicKey
+	Metadata                  MetadataArgs
+}
+
+func MintToCollectionV1(param MintToCollectionV1Param) types.Instruction {
+	data, err := borsh.Serialize(struct {
+		Instruction Instruction
+		Metadata    MetadataArgs
+	}{
+		Instruction: InstructionMintToCollectionV1,
+		Metadata:    param.Metadata,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: common.MetaplexBubblegumProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: param.TreeConfig, IsSigner: false, IsWritable: true},
+			{PubKey: param.LeafOwner, IsSigner: false, IsWritable: false},
+			{PubKey: param.LeafDelegate, IsSigner: false, IsWritable: false},
+			{PubKey: param.MerkleTree, IsSigner: false, IsWritable: true},
+			{PubKey: param.Payer, IsSigner: true, IsWritable: false},
+			{PubKey: param.TreeCreatorOrDelegate, IsSigner: true, IsWritable: false},
+			{PubKey: param.CollectionAuthority, IsSigner: true, IsWritable: false},
+			{PubKey: optionalAccount(param.CollectionAuthorityRecord), IsSigner: false, IsWritable: false},
+			{PubKey: param.CollectionMint, IsSigner: false, IsWritable: false},
+			{PubKey: param.CollectionMetadata, IsSigner: false, IsWritable: true},
+			{PubKey: param.CollectionEdition, IsSigner: false, IsWritable: false},
+			{PubKey: param.BubblegumSigner, IsSigner: false, IsWritable: false},
+			{PubKey: common.SPLNoopProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: common.SPLAccountCompressionProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: common.MetaplexTokenMetaProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+		},
+		Data: data,
+	}
+}
+
+// LeafParam identifies the current state of a leaf, the program recomputes its hash and checks it against root.
+// Proof is passed as remaining accounts, without the nodes the tree's canopy already holds.
+type LeafParam struct {
+	Root        [32]byte
+	DataHash    [32]byte
+	CreatorHash [32]byte
+	Nonce       uint64
+	Index       uint32
+	Proof       []common.PublicKey
+}
+
+type leafArgs struct {
+	Root        [32]byte
+	DataHash    [32]byte
+	CreatorHash [32]byte
+	Nonce       uint64
+	Index       uint32
+}
+
+func (p LeafParam) args() leafArgs {
+	return leafArgs{
+		Root:        p.Root,
+		DataHash:    p.DataHash,
+		CreatorHash: p.CreatorHash,
+		Nonce:       p.Nonce,
+		Index:       p.Index,
+	}
+}
+
+func (p LeafParam) proofAccounts() []types.AccountMeta {
+	accounts := make([]types.AccountMeta, 0, len(p.Proof))
+	for _, node := range p.Proof {
+		accounts = append(accounts, types.AccountMeta{PubKey: node, IsSigner: false, IsWritable: false})
+	}
+	return accounts
+}
+
+type TransferParam struct {
+	TreeConfig   common.PublicKey
+	LeafOwner    common.PublicKey
+	LeafDelegate common.PublicKey
+	// LeafDelegateIsSigner makes the delegate sign instead of the owner
+	LeafDelegateIsSigner bool
+	NewLeafOwner         common.PublicKey
+	MerkleTree           common.PublicKey
+	Leaf                 LeafParam
+}
+
+func Transfer(param TransferParam) types.Instruction {
+	data, err := borsh.Serialize(struct {
+		Instruction Instruction
+		Leaf        leafArgs
+	}{
+		Instruction: InstructionTransfer,
+		Leaf:        param.Leaf.args(),
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: common.MetaplexBubblegumProgramID,
+		Accounts: append([]types.AccountMeta{
+			{PubKey: param.TreeConfig, IsSigner: false, IsWritable: false},
+			{PubKey: param.LeafOwner, IsSigner: !param.LeafDelegateIsSigner, IsWritable: false},
+			{PubKey: param.LeafDelegate, IsSigner: param.LeafDelegateIsSigner, IsWritable: false},
+			{PubKey: param.NewLeafOwner, IsSigner: false, IsWritable: false},
+			{PubKey: param.MerkleTree, IsSigner: false, IsWritable: true},
+			{PubKey: common.SPLNoopProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: common.SPLAccountCompressionProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+		}, param.Leaf.proofAccounts()...),
+		Data: data,
+	}
+}
+
+type BurnParam struct {
+	TreeConfig   common.PublicKey
+	LeafOwner    common.PublicKey
+	LeafDelegate common.PublicKey
+	// LeafDelegateIsSigner makes the delegate sign instead of the owner
+	LeafDelegateIsSigner bool
+	MerkleTree           common.PublicKey
+	Leaf                 LeafParam
+}
+
+func Burn(param BurnParam) types.Instruction {
+	data, err := borsh.Serialize(struct {
+		Instruction Instruction
+		Leaf        leafArgs
+	}{
+		Instruction: InstructionBurn,
+		Leaf:        param.Leaf.args(),
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: common.MetaplexBubblegumProgramID,
+		Accounts: append([]types.AccountMeta{
+			{PubKey: param.TreeConfig, IsSigner: false, IsWritable: false},
+			{PubKey: param.LeafOwner, IsSigner: !param.LeafDelegateIsSigner, IsWritable: false},
+			{PubKey: param.LeafDelegate, IsSigner: param.LeafDelegateIsSigner, IsWritable: false},
+			{PubKey: param.MerkleTree, IsSigner: false, IsWritable: true},
+			{PubKey: common.SPLNoopProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: common.SPLAccountCompressionProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+		}, param.Leaf.proofAccounts()...),
+		Data: data,
+	}
+}
+
+type DelegateParam struct {
+	TreeConfig           common.PublicKey
+	LeafOwner            common.PublicKey
+	PreviousLeafDelegate common.PublicKey
+	NewLeafDelegate      common.PublicKey
+	MerkleTree           common.PublicKey
+	Leaf                 LeafParam
+}
+
+func Delegate(param DelegateParam) types.Instruction {
+	data, err := borsh.Serialize(struct {
+		Instruction Instruction
+		Leaf        leafArgs
+	}{
+		Instruction: InstructionDelegate,
+		Leaf:        param.Leaf.args(),
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: common.MetaplexBubblegumProgramID,
+		Accounts: append([]types.AccountMeta{
+			{PubKey: param.TreeConfig, IsSigner: false, IsWritable: false},
+			{PubKey: param.LeafOwner, IsSigner: true, IsWritable: false},
+			{PubKey: param.PreviousLeafDelegate, IsSigner: false, IsWritable: false},
+			{PubKey: param.NewLeafDelegate, IsSigner: false, IsWritable: false},
+			{PubKey: param.MerkleTree, IsSigner: false, IsWritable: true},
+			{PubKey: common.SPLNoopProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: common.SPLAccountCompressionProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+		}, param.Leaf.proofAccounts()...),
+		Data: data,
+	}
+}
+
+type RedeemParam struct {
+	TreeConfig   common.PublicKey
+	LeafOwner    common.PublicKey
+	LeafDelegate common.PublicKey
+	MerkleTree   common.PublicKey
+	Voucher      common.PublicKey
+	Leaf         LeafParam
+}
+
+// Redeem removes a leaf from the tree and creates a voucher which DecompressV1 turns into a regular nft
+func Redeem(param RedeemParam) types.Instruction {
+	data, err := borsh.Serialize(struct {
+		Instruction Instruction
+		Leaf        leafArgs
+	}{
+		Instruction: InstructionRedeem,
+		Leaf:        param.Leaf.args(),
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: common.MetaplexBubblegumProgramID,
+		Accounts: append([]types.AccountMeta{
+			{PubKey: param.TreeConfig, IsSigner: false, IsWritable: false},
+			{PubKey: param.LeafOwner, IsSigner: true, IsWritable: true},
+			{PubKey: param.LeafDelegate, IsSigner: false, IsWritable: false},
+			{PubKey: param.MerkleTree, IsSigner: false, IsWritable: true},
+			{PubKey: param.Voucher, IsSigner: false, IsWritable: true},
+			{PubKey: common.SPLNoopProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: common.SPLAccountCompressionProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+		}, param.Leaf.proofAccounts()...),
+		Data: data,
+	}
+}
+
+type CancelRedeemParam struct {
+	TreeConfig common.PublicKey
+	LeafOwner  common.PublicKey
+	MerkleTree common.PublicKey
+	Voucher    common.PublicKey
+	Root       [32]byte
+	Proof      []common.PublicKey
+}
+
+// CancelRedeem puts a redeemed leaf back into the tree and closes its voucher
+func CancelRedeem(param CancelRedeemParam) types.Instruction {
+	data, err := borsh.Serialize(struct {
+		Instruction Instruction
+		Root        [32]byte
+	}{
+		Instruction: InstructionCancelRedeem,
+		Root:        param.Root,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: common.MetaplexBubblegumProgramID,
+		Accounts: append([]types.AccountMeta{
+			{PubKey: param.TreeConfig, IsSigner: false, IsWritable: false},
+			{PubKey: param.LeafOwner, IsSigner: true, IsWritable: true},
+			{PubKey: param.MerkleTree, IsSigner: false, IsWritable: true},
+			{PubKey: param.Voucher, IsSigner: false, IsWritable: true},
+			{PubKey: common.SPLNoopProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: common.SPLAccountCompressionProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+		}, LeafParam{Proof: param.Proof}.proofAccounts()...),
+		Data: data,
+	}
+}
+
+type VerifyCreatorParam struct {
+	TreeConfig   common.PublicKey
+	LeafOwner    common.PublicKey
+	LeafDelegate common.PublicKey
+	MerkleTree   common.PublicKey
+	Payer        common.PublicKey
+	Creator      common.PublicKey
+	Leaf         LeafParam
+	// Metadata is the leaf's current metadata, the program derives the new data and creator hash from it
+	Metadata MetadataArgs
+}
+
+func VerifyCreator(param VerifyCreatorParam) types.Instruction {
+	return creatorVerification(InstructionVerifyCreator, param)
+}
+
+type UnverifyCreatorParam VerifyCreatorParam
+
+func UnverifyCreator(param UnverifyCreatorParam) types.Instruction {
+	return creatorVerification(InstructionUnverifyCreator, VerifyCreatorParam(param))
+}
+
+func creatorVerification(instruction Instruction, param VerifyCreatorParam) types.Instruction {
+	data, err := borsh.Serialize(struct {
+		Instruction Instruction
+		Leaf        leafArgs
+		Metadata    MetadataArgs
+	}{
+		Instruction: instruction,
+		Leaf:        param.Leaf.args(),
+		Metadata:    param.Metadata,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: common.MetaplexBubblegumProgramID,
+		Accounts: append([]types.AccountMeta{
+			{PubKey: param.TreeConfig, IsSigner: false, IsWritable: false},
+			{PubKey: param.LeafOwner, IsSigner: false, IsWritable: false},
+			{PubKey: param.LeafDelegate, IsSigner: false, IsWritable: false},
+			{PubKey: param.MerkleTree, IsSigner: false, IsWritable: true},
+			{PubKey: param.Payer, IsSigner: true, IsWritable: false},
+			{PubKey: param.Creator, IsSigner: true, IsWritable: false},
+			{PubKey: common.SPLNoopProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: common.SPLAccountCompressionProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+		}, param.Leaf.proofAccounts()...),
+		Data: data,
+	}
+}
+
+type VerifyCollectionParam struct {
+	TreeConfig            common.PublicKey
+	LeafOwner             common.PublicKey
+	LeafDelegate          common.PublicKey
+	MerkleTree            common.PublicKey
+	Payer                 common.PublicKey
+	TreeCreatorOrDelegate common.PublicKey
+	CollectionAuthority   common.PublicKey
+	// CollectionAuthorityRecord is only needed when the collection authority is a delegate
+	CollectionAuthorityRecord *common.PublicKey
+	CollectionMint            common.PublicKey
+	CollectionMetadata        common.PublicKey
+	CollectionEdition         common.PublicKey
+	BubblegumSigner           common.PublicKey
+	Leaf                      LeafParam
+	// Metadata is the leaf's current metadata, the program derives the new data hash from it
+	Metadata MetadataArgs
+}
+
+func VerifyCollection(param VerifyCollectionParam) types.Instruction {
+	data, err := borsh.Serialize(struct {
+		Instruction Instruction
+		Leaf        leafArgs
+		Metadata    MetadataArgs
+	}{
+		Instruction: InstructionVerifyCollection,
+		Leaf:        param.Leaf.args(),
+		Metadata:    param.Metadata,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return collectionVerification(param, false, data)
+}
+
+type SetAndVerifyCollectionParam struct {
+	VerifyCollectionParam
+	Collection common.PublicKey
+}
+
+// SetAndVerifyCollection sets the collection of a leaf which has none and verifies it.
+// Changing the collection needs the tree creator or delegate to sign as well.
+func SetAndVerifyCollection(param SetAndVerifyCollectionParam) types.Instruction {
+	data, err := borsh.Serialize(struct {
+		Instruction Instruction
+		Leaf        leafArgs
+		Metadata    MetadataArgs
+		Collection  common.PublicKey
+	}{
+		Instruction: InstructionSetAndVerifyCollection,
+		Leaf:        param.Leaf.args(),
+		Metadata:    param.Metadata,
+		Collection:  param.Collection,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return collectionVerification(param.VerifyCollectionParam, true, data)
+}
+
+func collectionVerification(param VerifyCollectionParam, treeCreatorOrDelegateIsSigner bool, data []byte) types.Instruction {
+	return types.Instruction{
+		ProgramID: common.MetaplexBubblegumProgramID,
+		Accounts: append([]types.AccountMeta{
+			{PubKey: param.TreeConfig, IsSigner: false, IsWritable: false},
+			{PubKey: param.LeafOwner, IsSigner: false, IsWritable: false},
+			{PubKey: param.LeafDelegate, IsSigner: false, IsWritable: false},
+			{PubKey: param.MerkleTree, IsSigner: false, IsWritable: true},
+			{PubKey: param.Payer, IsSigner: true, IsWritable: false},
+			{PubKey: param.TreeCreatorOrDelegate, IsSigner: treeCreatorOrDelegateIsSigner, IsWritable: false},
+			{PubKey: param.CollectionAuthority, IsSigner: true, IsWritable: false},
+			{PubKey: optionalAccount(param.CollectionAuthorityRecord), IsSigner: false, IsWritable: false},
+			{PubKey: param.CollectionMint, IsSigner: false, IsWritable: false},
+			{PubKey: param.CollectionMetadata, IsSigner: false, IsWritable: true},
+			{PubKey: param.CollectionEdition, IsSigner: false, IsWritable: false},
+			{PubKey: param.BubblegumSigner, IsSigner: false, IsWritable: false},
+			{PubKey: common.SPLNoopProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: common.SPLAccountCompressionProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: common.MetaplexTokenMetaProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+		}, param.Leaf.proofAccounts()...),
+		Data: data,
+	}
+}
+
+type DecompressV1Param struct {
+	Voucher       common.PublicKey
+	LeafOwner     common.PublicKey
+	TokenAccount  common.PublicKey
+	Mint          common.PublicKey
+	MintAuthority common.PublicKey
+	Metadata      common.PublicKey
+	MasterEdition common.PublicKey
+	MetadataArgs  MetadataArgs
+}
+
+// DecompressV1 turns a redeemed leaf into a regular nft, the mint is the leaf's asset id
+func DecompressV1(param DecompressV1Param) types.Instruction {
+	data, err := borsh.Serialize(struct {
+		Instruction Instruction
+		Metadata    MetadataArgs
+	}{
+		Instruction: InstructionDecompressV1,
+		Metadata:    param.MetadataArgs,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: common.MetaplexBubblegumProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: param.Voucher, IsSigner: false, IsWritable: true},
+			{PubKey: param.LeafOwner, IsSigner: true, IsWritable: true},
+			{PubKey: param.TokenAccount, IsSigner: false, IsWritable: true},
+			{PubKey: param.Mint, IsSigner: false, IsWritable: true},
+			{PubKey: param.MintAuthority, IsSigner: false, IsWritable: true},
+			{PubKey: param.Metadata, IsSigner: false, IsWritable: true},
+			{PubKey: param.MasterEdition, IsSigner: false, IsWritable: true},
+			{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: common.SysVarRentPubkey, IsSigner: false, IsWritable: false},
+			{PubKey: common.MetaplexTokenMetaProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: common.TokenProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: common.SPLAssociatedTokenAccountProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: common.SPLNoopProgramID, IsSigner: false, IsWritable: false},
+		},
+		Data: data,
+	}
+}
+
+// optionalAccount fills an optional account slot, anchor reads the program id as none
+func optionalAccount(account *common.PublicKey) common.PublicKey {
+	if account == nil {
+		return common.MetaplexBubblegumProgramID
+	}
+	return *account
+}
@@ -0,0 +1,304 @@
+package bubblegum
+
+import (
+	"encoding/binary"
+	"reflect"
+	"testing"
+
+	"github.com/qimeila/solana-go-sdk/common"
+	"github.com/qimeila/solana-go-sdk/pkg/pointer"
+	"github.com/qimeila/solana-go-sdk/program/metaplex/token_metadata"
+	"github.com/qimeila/solana-go-sdk/types"
+)
+
+func TestInstructionDiscriminator(t *testing.T) {
+	tests := []struct {
+		name        string
+		instruction Instruction
+		want        Instruction
+	}{
+		{name: "create_tree", instruction: InstructionCreateTree, want: Instruction{165, 83, 136, 142, 89, 202, 47, 220}},
+		{name: "mint_v1", instruction: InstructionMintV1, want: Instruction{145, 98, 192, 118, 184, 147, 118, 104}},
+		{name: "transfer", instruction: InstructionTransfer, want: Instruction{163, 52, 200, 231, 140, 3, 69, 186}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.instruction != tt.want {
+				t.Errorf("got %v, want %v", tt.instruction, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreateTree(t *testing.T) {
+	type args struct {
+		param CreateTreeParam
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Instruction
+	}{
+		{
+			args: args{
+				param: CreateTreeParam{
+					TreeConfig:    common.PublicKeyFromString("9BKWqDHfHZh9j39xakYVMdr6hXmCLHH5VfCpeq2idU9L"),
+					MerkleTree:    common.PublicKeyFromString("TrEEuqmjD6XKzRoqWzyPz8DrWFARV33hdhYKr1BCMyP"),
+					Payer:         common.PublicKeyFromString("9FYsKrNuEweb55Wa2jaj8wTKYDBvuCG3huhakEj96iN9"),
+					TreeCreator:   common.PublicKeyFromString("HNGVuL5kqjDehw7KR63w9gxow32sX6xzRNgLb8GkbwCM"),
+					MaxDepth:      14,
+					MaxBufferSize: 64,
+					Public:        pointer.Get[bool](false),
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.MetaplexBubblegumProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("9BKWqDHfHZh9j39xakYVMdr6hXmCLHH5VfCpeq2idU9L"), IsSigner: false, IsWritable: true},
+					{PubKey: common.PublicKeyFromString("TrEEuqmjD6XKzRoqWzyPz8DrWFARV33hdhYKr1BCMyP"), IsSigner: false, IsWritable: true},
+					{PubKey: common.PublicKeyFromString("9FYsKrNuEweb55Wa2jaj8wTKYDBvuCG3huhakEj96iN9"), IsSigner: true, IsWritable: true},
+					{PubKey: common.PublicKeyFromString("HNGVuL5kqjDehw7KR63w9gxow32sX6xzRNgLb8GkbwCM"), IsSigner: true, IsWritable: false},
+					{PubKey: common.SPLNoopProgramID, IsSigner: false, IsWritable: false},
+					{PubKey: common.SPLAccountCompressionProgramID, IsSigner: false, IsWritable: false},
+					{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+				},
+				Data: []byte{165, 83, 136, 142, 89, 202, 47, 220, 14, 0, 0, 0, 64, 0, 0, 0, 1, 0},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CreateTree(tt.args.param); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CreateTree() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMintV1(t *testing.T) {
+	type args struct {
+		param MintV1Param
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Instruction
+	}{
+		{
+			args: args{
+				param: MintV1Param{
+					TreeConfig:            common.PublicKeyFromString("9BKWqDHfHZh9j39xakYVMdr6hXmCLHH5VfCpeq2idU9L"),
+					LeafOwner:             common.PublicKeyFromString("DC2mkgwhy56w3viNtHDjJQmc7SGu2QX785bS4aexojwX"),
+					LeafDelegate:          common.PublicKeyFromString("DC2mkgwhy56w3viNtHDjJQmc7SGu2QX785bS4aexojwX"),
+					MerkleTree:            common.PublicKeyFromString("TrEEuqmjD6XKzRoqWzyPz8DrWFARV33hdhYKr1BCMyP"),
+					Payer:                 common.PublicKeyFromString("9FYsKrNuEweb55Wa2jaj8wTKYDBvuCG3huhakEj96iN9"),
+					TreeCreatorOrDelegate: common.PublicKeyFromString("HNGVuL5kqjDehw7KR63w9gxow32sX6xzRNgLb8GkbwCM"),
+					Metadata: MetadataArgs{
+						Name:                 "A",
+						Symbol:               "B",
+						Uri:                  "C",
+						SellerFeeBasisPoints: 500,
+						IsMutable:            true,
+						Creators: []token_metadata.Creator{
+							{
+								Address:  common.PublicKeyFromString("HNGVuL5kqjDehw7KR63w9gxow32sX6xzRNgLb8GkbwCM"),
+								Verified: false,
+								Share:    100,
+							},
+						},
+					},
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.MetaplexBubblegumProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("9BKWqDHfHZh9j39xakYVMdr6hXmCLHH5VfCpeq2idU9L"), IsSigner: false, IsWritable: true},
+					{PubKey: common.PublicKeyFromString("DC2mkgwhy56w3viNtHDjJQmc7SGu2QX785bS4aexojwX"), IsSigner: false, IsWritable: false},
+					{PubKey: common.PublicKeyFromString("DC2mkgwhy56w3viNtHDjJQmc7SGu2QX785bS4aexojwX"), IsSigner: false, IsWritable: false},
+					{PubKey: common.PublicKeyFromString("TrEEuqmjD6XKzRoqWzyPz8DrWFARV33hdhYKr1BCMyP"), IsSigner: false, IsWritable: true},
+					{PubKey: common.PublicKeyFromString("9FYsKrNuEweb55Wa2jaj8wTKYDBvuCG3huhakEj96iN9"), IsSigner: true, IsWritable: false},
+					{PubKey: common.PublicKeyFromString("HNGVuL5kqjDehw7KR63w9gxow32sX6xzRNgLb8GkbwCM"), IsSigner: true, IsWritable: false},
+					{PubKey: common.SPLNoopProgramID, IsSigner: false, IsWritable: false},
+					{PubKey: common.SPLAccountCompressionProgramID, IsSigner: false, IsWritable: false},
+					{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+				},
+				Data: append(
+					[]byte{
+						145, 98, 192, 118, 184, 147, 118, 104,
+						1, 0, 0, 0, 'A',
+						1, 0, 0, 0, 'B',
+						1, 0, 0, 0, 'C',
+						0xf4, 0x01, // seller fee basis points
+						0, 1, // primary sale happened, is mutable
+						0, 0, 0, 0, // edition nonce, token standard, collection, uses
+						0,          // token program version
+						1, 0, 0, 0, // creators
+					},
+					append(common.PublicKeyFromString("HNGVuL5kqjDehw7KR63w9gxow32sX6xzRNgLb8GkbwCM").Bytes(), 0, 100)...,
+				),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MintV1(tt.args.param); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MintV1() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTransfer(t *testing.T) {
+	leaf := LeafParam{
+		Root:        [32]byte{1},
+		DataHash:    [32]byte{2},
+		CreatorHash: [32]byte{3},
+		Nonce:       805306,
+		Index:       805306,
+		Proof: []common.PublicKey{
+			common.PublicKeyFromString("3RDSyGbEbENEZAnNsgGqNzxJgsLXQf5GdNkgqhJU4193"),
+			common.PublicKeyFromString("7FzXBBPjzrNJbm9MrZKZcyvP3ojVeYPUG2XkBPVZvuBu"),
+		},
+	}
+	leafData := func() []byte {
+		data := make([]byte, 0, 108)
+		data = append(data, leaf.Root[:]...)
+		data = append(data, leaf.DataHash[:]...)
+		data = append(data, leaf.CreatorHash[:]...)
+		data = binary.LittleEndian.AppendUint64(data, leaf.Nonce)
+		data = binary.LittleEndian.AppendUint32(data, leaf.Index)
+		return data
+	}()
+
+	type args struct {
+		param TransferParam
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Instruction
+	}{
+		{
+			name: "owner signs",
+			args: args{
+				param: TransferParam{
+					TreeConfig:   common.PublicKeyFromString("9BKWqDHfHZh9j39xakYVMdr6hXmCLHH5VfCpeq2idU9L"),
+					LeafOwner:    common.PublicKeyFromString("DC2mkgwhy56w3viNtHDjJQmc7SGu2QX785bS4aexojwX"),
+					LeafDelegate: common.PublicKeyFromString("GphF2vTuzhwhLWBWWvD8y5QLCPp1aQC5EnzrWsnbiWPx"),
+					NewLeafOwner: common.PublicKeyFromString("9FYsKrNuEweb55Wa2jaj8wTKYDBvuCG3huhakEj96iN9"),
+					MerkleTree:   common.PublicKeyFromString("TrEEuqmjD6XKzRoqWzyPz8DrWFARV33hdhYKr1BCMyP"),
+					Leaf:         leaf,
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.MetaplexBubblegumProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("9BKWqDHfHZh9j39xakYVMdr6hXmCLHH5VfCpeq2idU9L"), IsSigner: false, IsWritable: false},
+					{PubKey: common.PublicKeyFromString("DC2mkgwhy56w3viNtHDjJQmc7SGu2QX785bS4aexojwX"), IsSigner: true, IsWritable: false},
+					{PubKey: common.PublicKeyFromString("GphF2vTuzhwhLWBWWvD8y5QLCPp1aQC5EnzrWsnbiWPx"), IsSigner: false, IsWritable: false},
+					{PubKey: common.PublicKeyFromString("9FYsKrNuEweb55Wa2jaj8wTKYDBvuCG3huhakEj96iN9"), IsSigner: false, IsWritable: false},
+					{PubKey: common.PublicKeyFromString("TrEEuqmjD6XKzRoqWzyPz8DrWFARV33hdhYKr1BCMyP"), IsSigner: false, IsWritable: true},
+					{PubKey: common.SPLNoopProgramID, IsSigner: false, IsWritable: false},
+					{PubKey: common.SPLAccountCompressionProgramID, IsSigner: false, IsWritable: false},
+					{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+					{PubKey: common.PublicKeyFromString("3RDSyGbEbENEZAnNsgGqNzxJgsLXQf5GdNkgqhJU4193"), IsSigner: false, IsWritable: false},
+					{PubKey: common.PublicKeyFromString("7FzXBBPjzrNJbm9MrZKZcyvP3ojVeYPUG2XkBPVZvuBu"), IsSigner: false, IsWritable: false},
+				},
+				Data: append([]byte{163, 52, 200, 231, 140, 3, 69, 186}, leafData...),
+			},
+		},
+		{
+			name: "delegate signs",
+			args: args{
+				param: TransferParam{
+					TreeConfig:           common.PublicKeyFromString("9BKWqDHfHZh9j39xakYVMdr6hXmCLHH5VfCpeq2idU9L"),
+					LeafOwner:            common.PublicKeyFromString("DC2mkgwhy56w3viNtHDjJQmc7SGu2QX785bS4aexojwX"),
+					LeafDelegate:         common.PublicKeyFromString("GphF2vTuzhwhLWBWWvD8y5QLCPp1aQC5EnzrWsnbiWPx"),
+					LeafDelegateIsSigner: true,
+					NewLeafOwner:         common.PublicKeyFromString("9FYsKrNuEweb55Wa2jaj8wTKYDBvuCG3huhakEj96iN9"),
+					MerkleTree:           common.PublicKeyFromString("TrEEuqmjD6XKzRoqWzyPz8DrWFARV33hdhYKr1BCMyP"),
+					Leaf:                 LeafParam{Root: leaf.Root, DataHash: leaf.DataHash, CreatorHash: leaf.CreatorHash, Nonce: leaf.Nonce, Index: leaf.Index},
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.MetaplexBubblegumProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("9BKWqDHfHZh9j39xakYVMdr6hXmCLHH5VfCpeq2idU9L"), IsSigner: false, IsWritable: false},
+					{PubKey: common.PublicKeyFromString("DC2mkgwhy56w3viNtHDjJQmc7SGu2QX785bS4aexojwX"), IsSigner: false, IsWritable: false},
+					{PubKey: common.PublicKeyFromString("GphF2vTuzhwhLWBWWvD8y5QLCPp1aQC5EnzrWsnbiWPx"), IsSigner: true, IsWritable: false},
+					{PubKey: common.PublicKeyFromString("9FYsKrNuEweb55Wa2jaj8wTKYDBvuCG3huhakEj96iN9"), IsSigner: false, IsWritable: false},
+					{PubKey: common.PublicKeyFromString("TrEEuqmjD6XKzRoqWzyPz8DrWFARV33hdhYKr1BCMyP"), IsSigner: false, IsWritable: true},
+					{PubKey: common.SPLNoopProgramID, IsSigner: false, IsWritable: false},
+					{PubKey: common.SPLAccountCompressionProgramID, IsSigner: false, IsWritable: false},
+					{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+				},
+				Data: append([]byte{163, 52, 200, 231, 140, 3, 69, 186}, leafData...),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Transfer(tt.args.param); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Transfer() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetAndVerifyCollection(t *testing.T) {
+	collection := common.PublicKeyFromString("7FzXBBPjzrNJbm9MrZKZcyvP3ojVeYPUG2XkBPVZvuBu")
+	verifyParam := VerifyCollectionParam{
+		TreeConfig:            common.PublicKeyFromString("9BKWqDHfHZh9j39xakYVMdr6hXmCLHH5VfCpeq2idU9L"),
+		LeafOwner:             common.PublicKeyFromString("DC2mkgwhy56w3viNtHDjJQmc7SGu2QX785bS4aexojwX"),
+		LeafDelegate:          common.PublicKeyFromString("DC2mkgwhy56w3viNtHDjJQmc7SGu2QX785bS4aexojwX"),
+		MerkleTree:            common.PublicKeyFromString("TrEEuqmjD6XKzRoqWzyPz8DrWFARV33hdhYKr1BCMyP"),
+		Payer:                 common.PublicKeyFromString("9FYsKrNuEweb55Wa2jaj8wTKYDBvuCG3huhakEj96iN9"),
+		TreeCreatorOrDelegate: common.PublicKeyFromString("HNGVuL5kqjDehw7KR63w9gxow32sX6xzRNgLb8GkbwCM"),
+		CollectionAuthority:   common.PublicKeyFromString("5xot9PVkphiX2adznghwrAuxGs2zeWisNSxMW6hU6Hkj"),
+		CollectionMint:        collection,
+		CollectionMetadata:    common.PublicKeyFromString("GphF2vTuzhwhLWBWWvD8y5QLCPp1aQC5EnzrWsnbiWPx"),
+		CollectionEdition:     common.PublicKeyFromString("3RDSyGbEbENEZAnNsgGqNzxJgsLXQf5GdNkgqhJU4193"),
+		BubblegumSigner:       common.PublicKeyFromString("4ewWZC5gT6TGpm5LZNDs9wVonfUT2q5PP5sc9kVbwMAK"),
+		Leaf: LeafParam{
+			Proof: []common.PublicKey{common.PublicKeyFromString("27kVX7JpPZ1bsrSckbR76mV6GeRqtrjoddubfg2zBpHZ")},
+		},
+	}
+	got := SetAndVerifyCollection(SetAndVerifyCollectionParam{
+		VerifyCollectionParam: verifyParam,
+		Collection:            collection,
+	})
+
+	wantAccounts := []types.AccountMeta{
+		{PubKey: verifyParam.TreeConfig, IsSigner: false, IsWritable: false},
+		{PubKey: verifyParam.LeafOwner, IsSigner: false, IsWritable: false},
+		{PubKey: verifyParam.LeafDelegate, IsSigner: false, IsWritable: false},
+		{PubKey: verifyParam.MerkleTree, IsSigner: false, IsWritable: true},
+		{PubKey: verifyParam.Payer, IsSigner: true, IsWritable: false},
+		{PubKey: verifyParam.TreeCreatorOrDelegate, IsSigner: true, IsWritable: false},
+		{PubKey: verifyParam.CollectionAuthority, IsSigner: true, IsWritable: false},
+		{PubKey: common.MetaplexBubblegumProgramID, IsSigner: false, IsWritable: false},
+		{PubKey: collection, IsSigner: false, IsWritable: false},
+		{PubKey: verifyParam.CollectionMetadata, IsSigner: false, IsWritable: true},
+		{PubKey: verifyParam.CollectionEdition, IsSigner: false, IsWritable: false},
+		{PubKey: verifyParam.BubblegumSigner, IsSigner: false, IsWritable: false},
+		{PubKey: common.SPLNoopProgramID, IsSigner: false, IsWritable: false},
+		{PubKey: common.SPLAccountCompressionProgramID, IsSigner: false, IsWritable: false},
+		{PubKey: common.MetaplexTokenMetaProgramID, IsSigner: false, IsWritable: false},
+		{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+		{PubKey: common.PublicKeyFromString("27kVX7JpPZ1bsrSckbR76mV6GeRqtrjoddubfg2zBpHZ"), IsSigner: false, IsWritable: false},
+	}
+	if !reflect.DeepEqual(got.Accounts, wantAccounts) {
+		t.Errorf("SetAndVerifyCollection() accounts = %v, want %v", got.Accounts, wantAccounts)
+	}
+	if !reflect.DeepEqual(got.Data[:8], InstructionSetAndVerifyCollection[:]) {
+		t.Errorf("discriminator = %v, want %v", got.Data[:8], InstructionSetAndVerifyCollection)
+	}
+	if !reflect.DeepEqual(got.Data[len(got.Data)-32:], collection.Bytes()) {
+		t.Errorf("collection is not the last argument, data = %v", got.Data)
+	}
+
+	// verifying an already set collection does not need the tree creator or delegate
+	if got := VerifyCollection(verifyParam); got.Accounts[5].IsSigner {
+		t.Errorf("VerifyCollection() tree creator or delegate = %v, want not a signer", got.Accounts[5])
+	}
+}
@@ -0,0 +1,96 @@
+package bubblegum
+
+import (
+	"encoding/binary"
+
+	"github.com/qimeila/solana-go-sdk/common"
+	"github.com/qimeila/solana-go-sdk/pkg/borsh"
+	"github.com/qimeila/solana-go-sdk/program/metaplex/token_metadata"
+	"golang.org/x/crypto/sha3"
+)
+
+type TokenProgramVersion borsh.Enum
+
+const (
+	TokenProgramVersionOriginal TokenProgramVersion = iota
+	TokenProgramVersionToken2022
+)
+
+// MetadataArgs is the metadata of a compressed nft, its hash is the leaf's data hash
+type MetadataArgs struct {
+	Name                 string
+	Symbol               string
+	Uri                  string
+	SellerFeeBasisPoints uint16
+	PrimarySaleHappened  bool
+	IsMutable            bool
+	EditionNonce         *uint8
+	TokenStandard        *token_metadata.TokenStandard
+	Collection           *token_metadata.Collection
+	Uses                 *token_metadata.Uses
+	TokenProgramVersion  TokenProgramVersion
+	Creators             []token_metadata.Creator
+}
+
+const LeafSchemaVersionV1 uint8 = 1
+
+// LeafSchema is what a leaf of a bubblegum tree commits to
+type LeafSchema struct {
+	ID          common.PublicKey
+	Owner       common.PublicKey
+	Delegate    common.PublicKey
+	Nonce       uint64
+	DataHash    [32]byte
+	CreatorHash [32]byte
+}
+
+// Hash returns the leaf node stored in the merkle tree
+func (l LeafSchema) Hash() [32]byte {
+	nonce := make([]byte, 8)
+	binary.LittleEndian.PutUint64(nonce, l.Nonce)
+	return keccak256(
+		[]byte{LeafSchemaVersionV1},
+		l.ID.Bytes(),
+		l.Owner.Bytes(),
+		l.Delegate.Bytes(),
+		nonce,
+		l.DataHash[:],
+		l.CreatorHash[:],
+	)
+}
+
+// HashMetadata returns the data hash, keccak256(keccak256(borsh(metadata)) || seller fee basis points)
+func HashMetadata(metadata MetadataArgs) ([32]byte, error) {
+	b, err := borsh.Serialize(metadata)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	metadataHash := keccak256(b)
+	sellerFeeBasisPoints := make([]byte, 2)
+	binary.LittleEndian.PutUint16(sellerFeeBasisPoints, metadata.SellerFeeBasisPoints)
+	return keccak256(metadataHash[:], sellerFeeBasisPoints), nil
+}
+
+// HashCreators returns the creator hash, keccak256 of every address || verified || share
+func HashCreators(creators []token_metadata.Creator) [32]byte {
+	data := make([]byte, 0, len(creators)*34)
+	for _, c := range creators {
+		data = append(data, c.Address.Bytes()...)
+		verified := byte(0)
+		if c.Verified {
+			verified = 1
+		}
+		data = append(data, verified, c.Share)
+	}
+	return keccak256(data)
+}
+
+func keccak256(data ...[]byte) [32]byte {
+	h := sha3.NewLegacyKeccak256()
+	for _, b := range data {
+		h.Write(b)
+	}
+	var out [32]byte
+	copy(out[:], h.Sum(nil))
+	return out
+}
@@ -0,0 +1,107 @@
+package bubblegum
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/qimeila/solana-go-sdk/common"
+	"github.com/qimeila/solana-go-sdk/pkg/borsh"
+	"github.com/qimeila/solana-go-sdk/program/metaplex/token_metadata"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/sha3"
+)
+
+func keccak(data []byte) []byte {
+	h := sha3.NewLegacyKeccak256()
+	h.Write(data)
+	return h.Sum(nil)
+}
+
+func TestHashMetadata(t *testing.T) {
+	metadata := MetadataArgs{
+		Name:                 "Compressed #1",
+		Symbol:               "CNFT",
+		Uri:                  "https://example.com/1.json",
+		SellerFeeBasisPoints: 500,
+		IsMutable:            true,
+		Collection: &token_metadata.Collection{
+			Verified: true,
+			Key:      common.PublicKeyFromString("7FzXBBPjzrNJbm9MrZKZcyvP3ojVeYPUG2XkBPVZvuBu"),
+		},
+		Creators: []token_metadata.Creator{
+			{Address: common.PublicKeyFromString("HNGVuL5kqjDehw7KR63w9gxow32sX6xzRNgLb8GkbwCM"), Verified: true, Share: 100},
+		},
+	}
+
+	serialized, err := borsh.Serialize(metadata)
+	assert.NoError(t, err)
+	want := keccak(append(keccak(serialized), 0xf4, 0x01))
+
+	got, err := HashMetadata(metadata)
+	assert.NoError(t, err)
+	assert.Equal(t, want, got[:])
+
+	metadata.SellerFeeBasisPoints = 501
+	changed, err := HashMetadata(metadata)
+	assert.NoError(t, err)
+	assert.NotEqual(t, got, changed)
+}
+
+func TestHashCreators(t *testing.T) {
+	a := common.PublicKeyFromString("HNGVuL5kqjDehw7KR63w9gxow32sX6xzRNgLb8GkbwCM")
+	b := common.PublicKeyFromString("9FYsKrNuEweb55Wa2jaj8wTKYDBvuCG3huhakEj96iN9")
+
+	tests := []struct {
+		name     string
+		creators []token_metadata.Creator
+		want     []byte
+	}{
+		{
+			name:     "no creators",
+			creators: nil,
+			want:     keccak(nil),
+		},
+		{
+			name: "two creators",
+			creators: []token_metadata.Creator{
+				{Address: a, Verified: true, Share: 60},
+				{Address: b, Verified: false, Share: 40},
+			},
+			want: keccak(append(append(a.Bytes(), 1, 60), append(b.Bytes(), 0, 40)...)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := HashCreators(tt.creators)
+			assert.Equal(t, tt.want, got[:])
+		})
+	}
+}
+
+func TestLeafSchemaHash(t *testing.T) {
+	tree := common.PublicKeyFromString("TrEEuqmjD6XKzRoqWzyPz8DrWFARV33hdhYKr1BCMyP")
+	id, err := GetLeafAssetId(tree, 805306)
+	assert.NoError(t, err)
+	owner := common.PublicKeyFromString("DC2mkgwhy56w3viNtHDjJQmc7SGu2QX785bS4aexojwX")
+	delegate := common.PublicKeyFromString("GphF2vTuzhwhLWBWWvD8y5QLCPp1aQC5EnzrWsnbiWPx")
+
+	leaf := LeafSchema{
+		ID:          id,
+		Owner:       owner,
+		Delegate:    delegate,
+		Nonce:       805306,
+		DataHash:    [32]byte{1, 2, 3},
+		CreatorHash: [32]byte{4, 5, 6},
+	}
+
+	data := []byte{LeafSchemaVersionV1}
+	data = append(data, id.Bytes()...)
+	data = append(data, owner.Bytes()...)
+	data = append(data, delegate.Bytes()...)
+	data = binary.LittleEndian.AppendUint64(data, 805306)
+	data = append(data, leaf.DataHash[:]...)
+	data = append(data, leaf.CreatorHash[:]...)
+
+	got := leaf.Hash()
+	assert.Equal(t, keccak(data), got[:])
+}
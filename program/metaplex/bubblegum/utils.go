@@ -1,6 +1,7 @@
 package bubblegum
 
 import (
+	"encoding/binary"
 
 	"github.com/qimeila/solana-go-sdk/common"
 )
@@ -28,3 +29,50 @@ func GetLeafAssetId(tree common.PublicKey, leafIndex uint64) (common.PublicKey,
 		return assetId, nil
 
 }
+
+// GetTreeConfigAddress returns the tree config account, the tree authority, of a merkle tree
+func GetTreeConfigAddress(tree common.PublicKey) (common.PublicKey, error) {
+	treeConfig, _, err := common.FindProgramAddress(
+		[][]byte{
+			tree.Bytes(),
+		},
+		common.MetaplexBubblegumProgramID,
+	)
+	return treeConfig, err
+}
+
+func GetVoucherAddress(tree common.PublicKey, nonce uint64) (common.PublicKey, error) {
+	nonceBytes := make([]byte, 8)
+	binary.LittleEndian.PutUint64(nonceBytes, nonce)
+	voucher, _, err := common.FindProgramAddress(
+		[][]byte{
+			[]byte("voucher"),
+			tree.Bytes(),
+			nonceBytes,
+		},
+		common.MetaplexBubblegumProgramID,
+	)
+	return voucher, err
+}
+
+// GetBubblegumSignerAddress returns the account bubblegum signs the collection cpi with
+func GetBubblegumSignerAddress() (common.PublicKey, error) {
+	signer, _, err := common.FindProgramAddress(
+		[][]byte{
+			[]byte("collection_cpi"),
+		},
+		common.MetaplexBubblegumProgramID,
+	)
+	return signer, err
+}
+
+// GetMintAuthorityAddress returns the mint authority of a decompressed nft's mint
+func GetMintAuthorityAddress(mint common.PublicKey) (common.PublicKey, error) {
+	mintAuthority, _, err := common.FindProgramAddress(
+		[][]byte{
+			mint.Bytes(),
+		},
+		common.MetaplexBubblegumProgramID,
+	)
+	return mintAuthority, err
+}
@@ -0,0 +1,38 @@
+package json_metadata
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	ErrInvalidUri          = errors.New("invalid uri")
+	ErrUnexpectedStatus    = errors.New("unexpected status code")
+	ErrResponseTooLarge    = errors.New("response too large")
+	ErrInvalidJsonMetadata = errors.New("invalid json metadata")
+)
+
+// Mismatch is a field which differs between the json metadata and the on-chain metadata
+type Mismatch struct {
+	Field    string
+	OnChain  string
+	OffChain string
+}
+
+func (m Mismatch) Error() string {
+	return fmt.Sprintf("%v mismatch, on-chain: %q, off-chain: %q", m.Field, m.OnChain, m.OffChain)
+}
+
+// ValidationError lists every mismatch found by Validate
+type ValidationError struct {
+	Mismatches []Mismatch
+}
+
+func (e *ValidationError) Error() string {
+	s := make([]string, 0, len(e.Mismatches))
+	for _, m := range e.Mismatches {
+		s = append(s, m.Error())
+	}
+	return strings.Join(s, "; ")
+}
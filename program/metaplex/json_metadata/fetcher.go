@@ -0,0 +1,128 @@
+package json_metadata
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	DefaultIpfsGateway    = "https://ipfs.io/ipfs/"
+	DefaultArweaveGateway = "https://arweave.net/"
+	// DefaultMaxSize limits the size of a fetched json
+	DefaultMaxSize = 1 << 20
+)
+
+// Fetcher fetches and parses json metadata, the zero value is ready to use
+type Fetcher struct {
+	// HttpClient defaults to http.DefaultClient
+	HttpClient *http.Client
+	// IpfsGateway serves ipfs:// uris, e.g. "https://ipfs.io/ipfs/". When set, uris of other ipfs gateways
+	// are rewritten to it as well. ipfs:// uris use DefaultIpfsGateway when it is empty.
+	IpfsGateway string
+	// ArweaveGateway serves ar:// uris, when set arweave.net uris are rewritten to it as well.
+	// ar:// uris use DefaultArweaveGateway when it is empty.
+	ArweaveGateway string
+	// MaxSize defaults to DefaultMaxSize
+	MaxSize int64
+}
+
+// Fetch downloads the json at uri, Metadata.Data.Uri of a token, and parses it
+func (f Fetcher) Fetch(ctx context.Context, uri string) (JsonMetadata, error) {
+	resolved, err := f.ResolveUri(uri)
+	if err != nil {
+		return JsonMetadata{}, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, resolved, nil)
+	if err != nil {
+		return JsonMetadata{}, fmt.Errorf("failed to create request, err: %v", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	httpClient := f.HttpClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return JsonMetadata{}, fmt.Errorf("failed to do request, err: %v", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return JsonMetadata{}, fmt.Errorf("%w: %v", ErrUnexpectedStatus, res.StatusCode)
+	}
+
+	maxSize := f.MaxSize
+	if maxSize <= 0 {
+		maxSize = DefaultMaxSize
+	}
+	body, err := io.ReadAll(io.LimitReader(res.Body, maxSize+1))
+	if err != nil {
+		return JsonMetadata{}, fmt.Errorf("failed to read body, err: %v", err)
+	}
+	if int64(len(body)) > maxSize {
+		return JsonMetadata{}, ErrResponseTooLarge
+	}
+
+	var metadata JsonMetadata
+	if err := json.Unmarshal(body, &metadata); err != nil {
+		return JsonMetadata{}, fmt.Errorf("%w: %v", ErrInvalidJsonMetadata, err)
+	}
+	return metadata, nil
+}
+
+// ResolveUri rewrites ipfs and arweave uris to the gateways, other http uris are returned as they are
+func (f Fetcher) ResolveUri(uri string) (string, error) {
+	uri = strings.TrimRight(strings.TrimSpace(uri), "\x00")
+	u, err := url.Parse(uri)
+	if err != nil || u.Scheme == "" {
+		return "", fmt.Errorf("%w: %q", ErrInvalidUri, uri)
+	}
+
+	switch u.Scheme {
+	case "ipfs":
+		// ipfs://<cid>/<path>, some uris repeat the namespace as ipfs://ipfs/<cid>
+		path := strings.TrimPrefix(strings.TrimPrefix(uri, "ipfs://"), "ipfs/")
+		return withDefault(f.IpfsGateway, DefaultIpfsGateway) + path, nil
+	case "ar":
+		return withDefault(f.ArweaveGateway, DefaultArweaveGateway) + strings.TrimPrefix(uri, "ar://"), nil
+	case "http", "https":
+	default:
+		return "", fmt.Errorf("%w: unsupported scheme %q", ErrInvalidUri, u.Scheme)
+	}
+
+	if f.IpfsGateway != "" {
+		// path gateways, https://<gateway>/ipfs/<cid>/<path>
+		if i := strings.Index(u.Path, "/ipfs/"); i >= 0 {
+			return f.IpfsGateway + u.Path[i+len("/ipfs/"):] + query(u), nil
+		}
+		// subdomain gateways, https://<cid>.ipfs.<gateway>/<path>
+		if i := strings.Index(u.Host, ".ipfs."); i > 0 {
+			return f.IpfsGateway + u.Host[:i] + u.Path + query(u), nil
+		}
+	}
+	if f.ArweaveGateway != "" && (u.Host == "arweave.net" || strings.HasSuffix(u.Host, ".arweave.net")) {
+		return f.ArweaveGateway + strings.TrimPrefix(u.Path, "/") + query(u), nil
+	}
+	return uri, nil
+}
+
+func withDefault(gateway, defaultGateway string) string {
+	if gateway == "" {
+		return defaultGateway
+	}
+	return gateway
+}
+
+func query(u *url.URL) string {
+	if u.RawQuery == "" {
+		return ""
+	}
+	return "?" + u.RawQuery
+}
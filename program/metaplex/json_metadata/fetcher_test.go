@@ -0,0 +1,157 @@
+package json_metadata
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/qimeila/solana-go-sdk/pkg/pointer"
+	"github.com/stretchr/testify/assert"
+)
+
+const testJson = `{
+	"name": "Degen Ape #1829",
+	"symbol": "DAPE",
+	"description": "Degen Ape Academy",
+	"seller_fee_basis_points": 420,
+	"image": "https://arweave.net/6ePcw3g2wI-kJRFftU_dQDaP70myKpMxSVEDdGlbNe0",
+	"animation_url": "https://arweave.net/anim.mp4",
+	"external_url": "https://degenape.academy",
+	"attributes": [{"trait_type": "Fur", "value": "Gold"}, {"trait_type": "Level", "value": 3, "display_type": "number"}],
+	"properties": {
+		"files": [{"uri": "https://arweave.net/6ePcw3g2wI-kJRFftU_dQDaP70myKpMxSVEDdGlbNe0", "type": "image/png"}],
+		"category": "image",
+		"creators": [{"address": "9BKWqDHfHZh9j39xakYVMdr6hXmCLHH5VfCpeq2idU9L", "share": 100}]
+	}
+}`
+
+func TestFetcher_Fetch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/ok.json":
+			_, _ = rw.Write([]byte(testJson))
+		case "/ipfs/bafy/1.json":
+			_, _ = rw.Write([]byte(`{"name":"ipfs"}`))
+		case "/large.json":
+			_, _ = rw.Write([]byte(`{"name":"` + strings.Repeat("a", 100) + `"}`))
+		case "/invalid.json":
+			_, _ = rw.Write([]byte(`<html></html>`))
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name    string
+		fetcher Fetcher
+		uri     string
+		want    JsonMetadata
+		wantErr error
+	}{
+		{
+			name:    "ok",
+			fetcher: Fetcher{HttpClient: server.Client()},
+			uri:     server.URL + "/ok.json",
+			want: JsonMetadata{
+				Name:                 "Degen Ape #1829",
+				Symbol:               "DAPE",
+				Description:          "Degen Ape Academy",
+				SellerFeeBasisPoints: pointer.Get[uint16](420),
+				Image:                "https://arweave.net/6ePcw3g2wI-kJRFftU_dQDaP70myKpMxSVEDdGlbNe0",
+				AnimationUrl:         "https://arweave.net/anim.mp4",
+				ExternalUrl:          "https://degenape.academy",
+				Attributes: []Attribute{
+					{TraitType: "Fur", Value: "Gold"},
+					{TraitType: "Level", Value: float64(3), DisplayType: "number"},
+				},
+				Properties: &Properties{
+					Files:    []File{{Uri: "https://arweave.net/6ePcw3g2wI-kJRFftU_dQDaP70myKpMxSVEDdGlbNe0", Type: "image/png"}},
+					Category: "image",
+					Creators: []Creator{{Address: "9BKWqDHfHZh9j39xakYVMdr6hXmCLHH5VfCpeq2idU9L", Share: 100}},
+				},
+			},
+		},
+		{
+			name:    "on-chain uri with null padding",
+			fetcher: Fetcher{},
+			uri:     server.URL + "/ok.json\x00\x00\x00",
+			want:    JsonMetadata{Name: "Degen Ape #1829"},
+		},
+		{
+			name:    "ipfs gateway",
+			fetcher: Fetcher{IpfsGateway: server.URL + "/ipfs/"},
+			uri:     "ipfs://bafy/1.json",
+			want:    JsonMetadata{Name: "ipfs"},
+		},
+		{
+			name:    "not found",
+			fetcher: Fetcher{},
+			uri:     server.URL + "/missing.json",
+			wantErr: ErrUnexpectedStatus,
+		},
+		{
+			name:    "too large",
+			fetcher: Fetcher{MaxSize: 50},
+			uri:     server.URL + "/large.json",
+			wantErr: ErrResponseTooLarge,
+		},
+		{
+			name:    "invalid json",
+			fetcher: Fetcher{},
+			uri:     server.URL + "/invalid.json",
+			wantErr: ErrInvalidJsonMetadata,
+		},
+		{
+			name:    "invalid uri",
+			fetcher: Fetcher{},
+			uri:     "not a uri",
+			wantErr: ErrInvalidUri,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.fetcher.Fetch(context.Background(), tt.uri)
+			assert.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr == nil {
+				assert.Equal(t, tt.want.Name, got.Name)
+				if tt.name == "ok" {
+					assert.Equal(t, tt.want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestFetcher_ResolveUri(t *testing.T) {
+	gateways := Fetcher{IpfsGateway: "https://gw.example/ipfs/", ArweaveGateway: "https://ar.example/"}
+
+	tests := []struct {
+		name    string
+		fetcher Fetcher
+		uri     string
+		want    string
+		wantErr error
+	}{
+		{name: "http", fetcher: gateways, uri: "https://example.com/1.json", want: "https://example.com/1.json"},
+		{name: "ipfs default", fetcher: Fetcher{}, uri: "ipfs://bafy/1.json", want: "https://ipfs.io/ipfs/bafy/1.json"},
+		{name: "ipfs namespace", fetcher: gateways, uri: "ipfs://ipfs/bafy", want: "https://gw.example/ipfs/bafy"},
+		{name: "ipfs path gateway", fetcher: gateways, uri: "https://nftstorage.link/ipfs/bafy/1.json?ext=json", want: "https://gw.example/ipfs/bafy/1.json?ext=json"},
+		{name: "ipfs subdomain gateway", fetcher: gateways, uri: "https://bafy.ipfs.nftstorage.link/1.json", want: "https://gw.example/ipfs/bafy/1.json"},
+		{name: "ipfs gateway left alone", fetcher: Fetcher{}, uri: "https://nftstorage.link/ipfs/bafy", want: "https://nftstorage.link/ipfs/bafy"},
+		{name: "arweave default", fetcher: Fetcher{}, uri: "ar://abc", want: "https://arweave.net/abc"},
+		{name: "arweave", fetcher: gateways, uri: "https://arweave.net/abc?ext=png", want: "https://ar.example/abc?ext=png"},
+		{name: "arweave subdomain", fetcher: gateways, uri: "https://xyz.arweave.net/abc", want: "https://ar.example/abc"},
+		{name: "unsupported scheme", fetcher: gateways, uri: "ftp://example.com/1.json", wantErr: ErrInvalidUri},
+		{name: "empty", fetcher: gateways, uri: "", wantErr: ErrInvalidUri},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.fetcher.ResolveUri(tt.uri)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
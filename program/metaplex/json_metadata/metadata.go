@@ -0,0 +1,119 @@
+package json_metadata
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/qimeila/solana-go-sdk/program/metaplex/token_metadata"
+)
+
+// JsonMetadata is the off-chain json of the metaplex token standard which Metadata.Data.Uri points at
+type JsonMetadata struct {
+	Name                 string      `json:"name"`
+	Symbol               string      `json:"symbol,omitempty"`
+	Description          string      `json:"description,omitempty"`
+	SellerFeeBasisPoints *uint16     `json:"seller_fee_basis_points,omitempty"`
+	Image                string      `json:"image,omitempty"`
+	AnimationUrl         string      `json:"animation_url,omitempty"`
+	ExternalUrl          string      `json:"external_url,omitempty"`
+	Attributes           []Attribute `json:"attributes,omitempty"`
+	Properties           *Properties `json:"properties,omitempty"`
+}
+
+type Attribute struct {
+	TraitType string `json:"trait_type"`
+	// Value is a string or a number
+	Value       any    `json:"value"`
+	DisplayType string `json:"display_type,omitempty"`
+}
+
+type Properties struct {
+	Files    []File    `json:"files,omitempty"`
+	Category string    `json:"category,omitempty"` // "image", "video", "audio", "vr", "html"
+	Creators []Creator `json:"creators,omitempty"`
+}
+
+type File struct {
+	Uri  string `json:"uri"`
+	Type string `json:"type,omitempty"`
+	Cdn  bool   `json:"cdn,omitempty"`
+}
+
+type Creator struct {
+	Address string `json:"address"`
+	Share   uint8  `json:"share"`
+}
+
+// Validate checks the json against the on-chain metadata it belongs to.
+// It compares the name, the symbol, the seller fee and the creators when the json has them,
+// and returns a *ValidationError which lists every mismatch.
+func (m JsonMetadata) Validate(metadata token_metadata.Metadata) error {
+	var mismatches []Mismatch
+	data := metadata.Data
+
+	if name := strings.TrimSpace(m.Name); name != strings.TrimSpace(data.Name) {
+		mismatches = append(mismatches, Mismatch{Field: "name", OnChain: data.Name, OffChain: m.Name})
+	}
+	if symbol := strings.TrimSpace(m.Symbol); symbol != "" && symbol != strings.TrimSpace(data.Symbol) {
+		mismatches = append(mismatches, Mismatch{Field: "symbol", OnChain: data.Symbol, OffChain: m.Symbol})
+	}
+	if m.SellerFeeBasisPoints != nil && *m.SellerFeeBasisPoints != data.SellerFeeBasisPoints {
+		mismatches = append(mismatches, Mismatch{
+			Field:    "seller_fee_basis_points",
+			OnChain:  strconv.FormatUint(uint64(data.SellerFeeBasisPoints), 10),
+			OffChain: strconv.FormatUint(uint64(*m.SellerFeeBasisPoints), 10),
+		})
+	}
+	if m.Properties != nil && len(m.Properties.Creators) > 0 {
+		onChain := []token_metadata.Creator{}
+		if data.Creators != nil {
+			onChain = *data.Creators
+		}
+		if !sameCreators(onChain, m.Properties.Creators) {
+			mismatches = append(mismatches, Mismatch{
+				Field:    "creators",
+				OnChain:  formatOnChainCreators(onChain),
+				OffChain: formatCreators(m.Properties.Creators),
+			})
+		}
+	}
+
+	if len(mismatches) > 0 {
+		return &ValidationError{Mismatches: mismatches}
+	}
+	return nil
+}
+
+func sameCreators(onChain []token_metadata.Creator, offChain []Creator) bool {
+	if len(onChain) != len(offChain) {
+		return false
+	}
+	shares := make(map[string]uint8, len(onChain))
+	for _, c := range onChain {
+		shares[c.Address.ToBase58()] = c.Share
+	}
+	for _, c := range offChain {
+		share, ok := shares[c.Address]
+		if !ok || share != c.Share {
+			return false
+		}
+	}
+	return true
+}
+
+func formatOnChainCreators(creators []token_metadata.Creator) string {
+	s := make([]string, 0, len(creators))
+	for _, c := range creators {
+		s = append(s, fmt.Sprintf("%v:%v", c.Address.ToBase58(), c.Share))
+	}
+	return strings.Join(s, ",")
+}
+
+func formatCreators(creators []Creator) string {
+	s := make([]string, 0, len(creators))
+	for _, c := range creators {
+		s = append(s, fmt.Sprintf("%v:%v", c.Address, c.Share))
+	}
+	return strings.Join(s, ",")
+}
@@ -0,0 +1,77 @@
+package json_metadata
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/qimeila/solana-go-sdk/common"
+	"github.com/qimeila/solana-go-sdk/pkg/pointer"
+	"github.com/qimeila/solana-go-sdk/program/metaplex/token_metadata"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJsonMetadata_Validate(t *testing.T) {
+	creator := common.PublicKeyFromString("9BKWqDHfHZh9j39xakYVMdr6hXmCLHH5VfCpeq2idU9L")
+	onChain := token_metadata.Metadata{
+		Data: token_metadata.Data{
+			Name:                 "Degen Ape #1829",
+			Symbol:               "DAPE",
+			SellerFeeBasisPoints: 420,
+			Creators:             &[]token_metadata.Creator{{Address: creator, Verified: true, Share: 100}},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		json           JsonMetadata
+		wantMismatches []string
+	}{
+		{
+			name: "consistent",
+			json: JsonMetadata{
+				Name:                 "Degen Ape #1829",
+				Symbol:               "DAPE",
+				SellerFeeBasisPoints: pointer.Get[uint16](420),
+				Properties:           &Properties{Creators: []Creator{{Address: creator.ToBase58(), Share: 100}}},
+			},
+		},
+		{
+			name: "optional fields left out",
+			json: JsonMetadata{Name: "Degen Ape #1829 "},
+		},
+		{
+			name: "mismatches",
+			json: JsonMetadata{
+				Name:                 "Degen Ape #1",
+				Symbol:               "APE",
+				SellerFeeBasisPoints: pointer.Get[uint16](500),
+				Properties:           &Properties{Creators: []Creator{{Address: creator.ToBase58(), Share: 50}}},
+			},
+			wantMismatches: []string{"name", "symbol", "seller_fee_basis_points", "creators"},
+		},
+		{
+			name: "unknown creator",
+			json: JsonMetadata{
+				Name:       "Degen Ape #1829",
+				Properties: &Properties{Creators: []Creator{{Address: "HNGVuL5kqjDehw7KR63w9gxow32sX6xzRNgLb8GkbwCM", Share: 100}}},
+			},
+			wantMismatches: []string{"creators"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.json.Validate(onChain)
+			if tt.wantMismatches == nil {
+				assert.NoError(t, err)
+				return
+			}
+			var validationErr *ValidationError
+			assert.True(t, errors.As(err, &validationErr))
+			fields := []string{}
+			for _, m := range validationErr.Mismatches {
+				fields = append(fields, m.Field)
+			}
+			assert.Equal(t, tt.wantMismatches, fields)
+		})
+	}
+}
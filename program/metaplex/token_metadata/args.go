@@ -0,0 +1,185 @@
+package token_metadata
+
+import (
+	"github.com/qimeila/solana-go-sdk/common"
+	"github.com/qimeila/solana-go-sdk/pkg/borsh"
+)
+
+// AssetData is the metadata of an asset created by CreateV1
+type AssetData struct {
+	Name                 string
+	Symbol               string
+	Uri                  string
+	SellerFeeBasisPoints uint16
+	Creators             *[]Creator
+	PrimarySaleHappened  bool
+	IsMutable            bool
+	TokenStandard        TokenStandard
+	Collection           *Collection
+	Uses                 *Uses
+	CollectionDetails    *CollectionDetails
+	RuleSet              *common.PublicKey
+}
+
+const (
+	PrintSupplyZero borsh.Enum = iota
+	PrintSupplyLimited
+	PrintSupplyUnlimited
+)
+
+// PrintSupply is the max supply of prints of a master edition
+type PrintSupply struct {
+	Enum      borsh.Enum `borsh:"enum"`
+	Zero      struct{}
+	Limited   LimitedPrintSupply
+	Unlimited struct{}
+}
+
+type LimitedPrintSupply struct {
+	MaxSupply uint64
+}
+
+const (
+	ToggleNone borsh.Enum = iota
+	ToggleClear
+	ToggleSet
+)
+
+// CollectionToggle leaves, clears or sets the collection in UpdateV1, the zero value leaves it
+type CollectionToggle struct {
+	Enum  borsh.Enum `borsh:"enum"`
+	None  struct{}
+	Clear struct{}
+	Set   Collection
+}
+
+type CollectionDetailsToggle struct {
+	Enum  borsh.Enum `borsh:"enum"`
+	None  struct{}
+	Clear struct{}
+	Set   CollectionDetails
+}
+
+type UsesToggle struct {
+	Enum  borsh.Enum `borsh:"enum"`
+	None  struct{}
+	Clear struct{}
+	Set   Uses
+}
+
+type RuleSetToggle struct {
+	Enum  borsh.Enum `borsh:"enum"`
+	None  struct{}
+	Clear struct{}
+	Set   RuleSetToggleSet
+}
+
+type RuleSetToggleSet struct {
+	RuleSet common.PublicKey
+}
+
+// AuthorizationData is passed to the rule set of a programmable nft
+type AuthorizationData struct {
+	Payload Payload
+}
+
+type Payload struct {
+	Map map[string]PayloadType
+}
+
+const (
+	PayloadTypePubkey borsh.Enum = iota
+	PayloadTypeSeeds
+	PayloadTypeMerkleProof
+	PayloadTypeNumber
+)
+
+type PayloadType struct {
+	Enum        borsh.Enum `borsh:"enum"`
+	Pubkey      PayloadPubkey
+	Seeds       SeedsVec
+	MerkleProof ProofInfo
+	Number      PayloadNumber
+}
+
+type PayloadPubkey struct {
+	Pubkey common.PublicKey
+}
+
+type SeedsVec struct {
+	Seeds [][]byte
+}
+
+type ProofInfo struct {
+	Proof [][32]byte
+}
+
+type PayloadNumber struct {
+	Number uint64
+}
+
+// DelegateRole is the kind of delegate approved by Delegate and removed by Revoke.
+// The order follows DelegateArgs.
+type DelegateRole uint8
+
+const (
+	DelegateRoleCollection DelegateRole = iota
+	DelegateRoleSale
+	DelegateRoleTransfer
+	DelegateRoleData
+	DelegateRoleUtility
+	DelegateRoleStaking
+	DelegateRoleStandard
+	DelegateRoleLockedTransfer
+	DelegateRoleProgrammableConfig
+	DelegateRoleAuthorityItem
+	DelegateRoleDataItem
+	DelegateRoleCollectionItem
+	DelegateRoleProgrammableConfigItem
+	DelegateRolePrintDelegate
+)
+
+// IsTokenDelegate reports whether the role delegates a token account, its state is kept in the token record of a pnft
+func (r DelegateRole) IsTokenDelegate() bool {
+	switch r {
+	case DelegateRoleSale, DelegateRoleTransfer, DelegateRoleUtility, DelegateRoleStaking, DelegateRoleStandard, DelegateRoleLockedTransfer:
+		return true
+	}
+	return false
+}
+
+// seed is the seed of the delegate record, it is empty for token delegates
+func (r DelegateRole) seed() string {
+	switch r {
+	case DelegateRoleCollection:
+		return "collection_delegate"
+	case DelegateRoleData:
+		return "data_delegate"
+	case DelegateRoleProgrammableConfig:
+		return "programmable_config_delegate"
+	case DelegateRoleAuthorityItem:
+		return "authority_item_delegate"
+	case DelegateRoleDataItem:
+		return "data_item_delegate"
+	case DelegateRoleCollectionItem:
+		return "collection_item_delegate"
+	case DelegateRoleProgrammableConfigItem:
+		return "prog_config_item_delegate"
+	case DelegateRolePrintDelegate:
+		return "print_delegate"
+	}
+	return ""
+}
+
+// revokeArgs maps the role to RevokeArgs, which has MigrationV1 after ProgrammableConfigV1
+func (r DelegateRole) revokeArgs() uint8 {
+	if r > DelegateRoleProgrammableConfig {
+		return uint8(r) + 1
+	}
+	return uint8(r)
+}
+
+const (
+	VerificationArgsCreatorV1 uint8 = iota
+	VerificationArgsCollectionV1
+)
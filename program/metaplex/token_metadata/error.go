@@ -0,0 +1,9 @@
+package token_metadata
+
+import "errors"
+
+var (
+	ErrInvalidAccountOwner    = errors.New("invalid account owner")
+	ErrInvalidAccountDataSize = errors.New("invalid account data size")
+	ErrInvalidAccountKey      = errors.New("invalid account key")
+)
@@ -4,8 +4,8 @@ import (
 	"reflect"
 	"testing"
 
-	"github.com/near/borsh-go"
 	"github.com/qimeila/solana-go-sdk/common"
+	"github.com/qimeila/solana-go-sdk/pkg/borsh"
 	"github.com/qimeila/solana-go-sdk/pkg/pointer"
 	"github.com/qimeila/solana-go-sdk/types"
 	"github.com/stretchr/testify/assert"
@@ -0,0 +1,659 @@
+package token_metadata
+
+import (
+	"github.com/qimeila/solana-go-sdk/common"
+	"github.com/qimeila/solana-go-sdk/pkg/borsh"
+	"github.com/qimeila/solana-go-sdk/program/associated_token_account"
+	"github.com/qimeila/solana-go-sdk/types"
+)
+
+// The unified instructions take versioned args and work for every token standard.
+// Accounts which can be derived are optional in the params, a nil one is derived from the mint,
+// the owners and the token standard. Token records are only used by programmable nfts.
+
+type CreateV1Param struct {
+	Mint common.PublicKey
+	// MintIsSigner is set when the mint doesn't exist yet and is created by the instruction
+	MintIsSigner            bool
+	MintAuthority           common.PublicKey
+	Payer                   common.PublicKey
+	UpdateAuthority         common.PublicKey
+	UpdateAuthorityIsSigner bool
+	AssetData               AssetData
+	Decimals                *uint8
+	PrintSupply             *PrintSupply
+	TokenProgramID          *common.PublicKey
+}
+
+func CreateV1(param CreateV1Param) types.Instruction {
+	data := serializeArgs(InstructionCreate, 0, struct {
+		AssetData   AssetData
+		Decimals    *uint8
+		PrintSupply *PrintSupply
+	}{
+		AssetData:   param.AssetData,
+		Decimals:    param.Decimals,
+		PrintSupply: param.PrintSupply,
+	})
+
+	masterEdition := common.MetaplexTokenMetaProgramID
+	if ts := param.AssetData.TokenStandard; ts == NonFungible || ts == ProgrammableNonFungible {
+		masterEdition = mustDerive(GetMasterEdition(param.Mint))
+	}
+
+	return types.Instruction{
+		ProgramID: common.MetaplexTokenMetaProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: mustDerive(GetTokenMetaPubkey(param.Mint)), IsSigner: false, IsWritable: true},
+			{PubKey: masterEdition, IsSigner: false, IsWritable: true},
+			{PubKey: param.Mint, IsSigner: param.MintIsSigner, IsWritable: true},
+			{PubKey: param.MintAuthority, IsSigner: true, IsWritable: false},
+			{PubKey: param.Payer, IsSigner: true, IsWritable: true},
+			{PubKey: param.UpdateAuthority, IsSigner: param.UpdateAuthorityIsSigner, IsWritable: false},
+			{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: common.SysVarInstructionsPubkey, IsSigner: false, IsWritable: false},
+			{PubKey: tokenProgram(param.TokenProgramID), IsSigner: false, IsWritable: false},
+		},
+		Data: data,
+	}
+}
+
+type MintV1Param struct {
+	Mint       common.PublicKey
+	TokenOwner common.PublicKey
+	// Token defaults to the associated token account of TokenOwner, it is created when missing
+	Token *common.PublicKey
+	// Authority is the mint authority, or the update authority of a pnft
+	Authority          common.PublicKey
+	Payer              common.PublicKey
+	Amount             uint64
+	TokenStandard      TokenStandard
+	DelegateRecord     *common.PublicKey
+	AuthorizationRules *common.PublicKey
+	AuthorizationData  *AuthorizationData
+	// Metadata is the mint's metadata, a nil AuthorizationRules is filled with its rule set
+	Metadata       *Metadata
+	TokenProgramID *common.PublicKey
+}
+
+func MintV1(param MintV1Param) types.Instruction {
+	data := serializeArgs(InstructionMint, 0, struct {
+		Amount            uint64
+		AuthorizationData *AuthorizationData
+	}{
+		Amount:            param.Amount,
+		AuthorizationData: param.AuthorizationData,
+	})
+
+	token := tokenAccount(param.Token, param.TokenOwner, param.Mint, param.TokenProgramID)
+	rulesProgram, rules := authorizationRules(param.AuthorizationRules, param.Metadata)
+
+	return types.Instruction{
+		ProgramID: common.MetaplexTokenMetaProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: token, IsSigner: false, IsWritable: true},
+			{PubKey: param.TokenOwner, IsSigner: false, IsWritable: false},
+			{PubKey: mustDerive(GetTokenMetaPubkey(param.Mint)), IsSigner: false, IsWritable: false},
+			{PubKey: edition(param.Mint, param.TokenStandard), IsSigner: false, IsWritable: true},
+			{PubKey: tokenRecord(param.Mint, token, param.TokenStandard), IsSigner: false, IsWritable: true},
+			{PubKey: param.Mint, IsSigner: false, IsWritable: true},
+			{PubKey: param.Authority, IsSigner: true, IsWritable: false},
+			{PubKey: optionalAccount(param.DelegateRecord), IsSigner: false, IsWritable: false},
+			{PubKey: param.Payer, IsSigner: true, IsWritable: true},
+			{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: common.SysVarInstructionsPubkey, IsSigner: false, IsWritable: false},
+			{PubKey: tokenProgram(param.TokenProgramID), IsSigner: false, IsWritable: false},
+			{PubKey: common.SPLAssociatedTokenAccountProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: rulesProgram, IsSigner: false, IsWritable: false},
+			{PubKey: rules, IsSigner: false, IsWritable: false},
+		},
+		Data: data,
+	}
+}
+
+type TransferV1Param struct {
+	Mint       common.PublicKey
+	TokenOwner common.PublicKey
+	// Token defaults to the associated token account of TokenOwner
+	Token            *common.PublicKey
+	DestinationOwner common.PublicKey
+	// Destination defaults to the associated token account of DestinationOwner, it is created when missing
+	Destination *common.PublicKey
+	// Authority is the token owner or a transfer delegate
+	Authority          common.PublicKey
+	Payer              common.PublicKey
+	Amount             uint64
+	TokenStandard      TokenStandard
+	AuthorizationRules *common.PublicKey
+	AuthorizationData  *AuthorizationData
+	// Metadata is the mint's metadata, a nil AuthorizationRules is filled with its rule set
+	Metadata       *Metadata
+	TokenProgramID *common.PublicKey
+}
+
+func TransferV1(param TransferV1Param) types.Instruction {
+	data := serializeArgs(InstructionTransfer, 0, struct {
+		Amount            uint64
+		AuthorizationData *AuthorizationData
+	}{
+		Amount:            param.Amount,
+		AuthorizationData: param.AuthorizationData,
+	})
+
+	token := tokenAccount(param.Token, param.TokenOwner, param.Mint, param.TokenProgramID)
+	destination := tokenAccount(param.Destination, param.DestinationOwner, param.Mint, param.TokenProgramID)
+	rulesProgram, rules := authorizationRules(param.AuthorizationRules, param.Metadata)
+
+	return types.Instruction{
+		ProgramID: common.MetaplexTokenMetaProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: token, IsSigner: false, IsWritable: true},
+			{PubKey: param.TokenOwner, IsSigner: false, IsWritable: false},
+			{PubKey: destination, IsSigner: false, IsWritable: true},
+			{PubKey: param.DestinationOwner, IsSigner: false, IsWritable: false},
+			{PubKey: param.Mint, IsSigner: false, IsWritable: false},
+			{PubKey: mustDerive(GetTokenMetaPubkey(param.Mint)), IsSigner: false, IsWritable: true},
+			{PubKey: edition(param.Mint, param.TokenStandard), IsSigner: false, IsWritable: false},
+			{PubKey: tokenRecord(param.Mint, token, param.TokenStandard), IsSigner: false, IsWritable: true},
+			{PubKey: tokenRecord(param.Mint, destination, param.TokenStandard), IsSigner: false, IsWritable: true},
+			{PubKey: param.Authority, IsSigner: true, IsWritable: false},
+			{PubKey: param.Payer, IsSigner: true, IsWritable: true},
+			{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: common.SysVarInstructionsPubkey, IsSigner: false, IsWritable: false},
+			{PubKey: tokenProgram(param.TokenProgramID), IsSigner: false, IsWritable: false},
+			{PubKey: common.SPLAssociatedTokenAccountProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: rulesProgram, IsSigner: false, IsWritable: false},
+			{PubKey: rules, IsSigner: false, IsWritable: false},
+		},
+		Data: data,
+	}
+}
+
+type BurnV1Param struct {
+	Mint common.PublicKey
+	// Authority is the token owner or a utility delegate
+	Authority common.PublicKey
+	// Token defaults to the associated token account of Authority
+	Token          *common.PublicKey
+	Amount         uint64
+	TokenStandard  TokenStandard
+	CollectionMint *common.PublicKey
+	// MasterEditionMint is the mint of the master edition when a print edition is burnt
+	MasterEditionMint  *common.PublicKey
+	MasterEditionToken *common.PublicKey
+	EditionNumber      uint64
+	TokenProgramID     *common.PublicKey
+}
+
+func BurnV1(param BurnV1Param) types.Instruction {
+	data := serializeArgs(InstructionBurn, 0, struct {
+		Amount uint64
+	}{
+		Amount: param.Amount,
+	})
+
+	token := tokenAccount(param.Token, param.Authority, param.Mint, param.TokenProgramID)
+
+	collectionMetadata := common.MetaplexTokenMetaProgramID
+	if param.CollectionMint != nil {
+		collectionMetadata = mustDerive(GetTokenMetaPubkey(*param.CollectionMint))
+	}
+	masterEdition, editionMarker := common.MetaplexTokenMetaProgramID, common.MetaplexTokenMetaProgramID
+	if param.MasterEditionMint != nil {
+		masterEdition = mustDerive(GetMasterEdition(*param.MasterEditionMint))
+		editionMarker = mustDerive(GetEditionMark(*param.MasterEditionMint, param.EditionNumber))
+	}
+
+	return types.Instruction{
+		ProgramID: common.MetaplexTokenMetaProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: param.Authority, IsSigner: true, IsWritable: true},
+			{PubKey: collectionMetadata, IsSigner: false, IsWritable: true},
+			{PubKey: mustDerive(GetTokenMetaPubkey(param.Mint)), IsSigner: false, IsWritable: true},
+			{PubKey: edition(param.Mint, param.TokenStandard), IsSigner: false, IsWritable: true},
+			{PubKey: param.Mint, IsSigner: false, IsWritable: true},
+			{PubKey: token, IsSigner: false, IsWritable: true},
+			{PubKey: masterEdition, IsSigner: false, IsWritable: true},
+			{PubKey: optionalAccount(param.MasterEditionMint), IsSigner: false, IsWritable: false},
+			{PubKey: optionalAccount(param.MasterEditionToken), IsSigner: false, IsWritable: false},
+			{PubKey: editionMarker, IsSigner: false, IsWritable: true},
+			{PubKey: tokenRecord(param.Mint, token, param.TokenStandard), IsSigner: false, IsWritable: true},
+			{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: common.SysVarInstructionsPubkey, IsSigner: false, IsWritable: false},
+			{PubKey: tokenProgram(param.TokenProgramID), IsSigner: false, IsWritable: false},
+		},
+		Data: data,
+	}
+}
+
+type DelegateParam struct {
+	Role     DelegateRole
+	Mint     common.PublicKey
+	Delegate common.PublicKey
+	// Authority is the update authority for metadata delegates, the token owner for token and print delegates
+	Authority common.PublicKey
+	Payer     common.PublicKey
+	// Token defaults to the associated token account of Authority, only token and print delegates use it
+	Token         *common.PublicKey
+	TokenStandard TokenStandard
+	// Amount is used by token delegates
+	Amount uint64
+	// LockedAddress is used by locked transfer delegates
+	LockedAddress      common.PublicKey
+	AuthorizationRules *common.PublicKey
+	AuthorizationData  *AuthorizationData
+	// Metadata is the mint's metadata, a nil AuthorizationRules is filled with its rule set
+	Metadata       *Metadata
+	TokenProgramID *common.PublicKey
+}
+
+// Delegate approves a delegate, the delegate record or the token record is derived from the role
+func Delegate(param DelegateParam) types.Instruction {
+	var args any
+	switch param.Role {
+	case DelegateRoleStandard:
+		args = struct {
+			Amount uint64
+		}{
+			Amount: param.Amount,
+		}
+	case DelegateRoleLockedTransfer:
+		args = struct {
+			Amount            uint64
+			LockedAddress     common.PublicKey
+			AuthorizationData *AuthorizationData
+		}{
+			Amount:            param.Amount,
+			LockedAddress:     param.LockedAddress,
+			AuthorizationData: param.AuthorizationData,
+		}
+	case DelegateRoleSale, DelegateRoleTransfer, DelegateRoleUtility, DelegateRoleStaking:
+		args = struct {
+			Amount            uint64
+			AuthorizationData *AuthorizationData
+		}{
+			Amount:            param.Amount,
+			AuthorizationData: param.AuthorizationData,
+		}
+	default:
+		args = struct {
+			AuthorizationData *AuthorizationData
+		}{
+			AuthorizationData: param.AuthorizationData,
+		}
+	}
+
+	return types.Instruction{
+		ProgramID: common.MetaplexTokenMetaProgramID,
+		Accounts:  delegateAccounts(param),
+		Data:      serializeArgs(InstructionDelegate, uint8(param.Role), args),
+	}
+}
+
+type RevokeParam = DelegateParam
+
+// Revoke removes a delegate approved by Delegate, Amount, LockedAddress and AuthorizationData are not used
+func Revoke(param RevokeParam) types.Instruction {
+	return types.Instruction{
+		ProgramID: common.MetaplexTokenMetaProgramID,
+		Accounts:  delegateAccounts(param),
+		Data:      serializeArgs(InstructionRevoke, param.Role.revokeArgs(), struct{}{}),
+	}
+}
+
+func delegateAccounts(param DelegateParam) []types.AccountMeta {
+	delegateRecord, token, record := common.MetaplexTokenMetaProgramID, common.MetaplexTokenMetaProgramID, common.MetaplexTokenMetaProgramID
+	tokenProgramID := common.MetaplexTokenMetaProgramID
+	if param.Role.IsTokenDelegate() || param.Role == DelegateRolePrintDelegate {
+		token = tokenAccount(param.Token, param.Authority, param.Mint, param.TokenProgramID)
+		tokenProgramID = tokenProgram(param.TokenProgramID)
+	}
+	if param.Role.IsTokenDelegate() {
+		record = tokenRecord(param.Mint, token, param.TokenStandard)
+	} else {
+		delegateRecord = mustDerive(GetDelegateRecord(param.Mint, param.Role, param.Authority, param.Delegate))
+	}
+	rulesProgram, rules := authorizationRules(param.AuthorizationRules, param.Metadata)
+
+	return []types.AccountMeta{
+		{PubKey: delegateRecord, IsSigner: false, IsWritable: true},
+		{PubKey: param.Delegate, IsSigner: false, IsWritable: false},
+		{PubKey: mustDerive(GetTokenMetaPubkey(param.Mint)), IsSigner: false, IsWritable: true},
+		{PubKey: edition(param.Mint, param.TokenStandard), IsSigner: false, IsWritable: false},
+		{PubKey: record, IsSigner: false, IsWritable: true},
+		{PubKey: param.Mint, IsSigner: false, IsWritable: false},
+		{PubKey: token, IsSigner: false, IsWritable: true},
+		{PubKey: param.Authority, IsSigner: true, IsWritable: false},
+		{PubKey: param.Payer, IsSigner: true, IsWritable: true},
+		{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+		{PubKey: common.SysVarInstructionsPubkey, IsSigner: false, IsWritable: false},
+		{PubKey: tokenProgramID, IsSigner: false, IsWritable: false},
+		{PubKey: rulesProgram, IsSigner: false, IsWritable: false},
+		{PubKey: rules, IsSigner: false, IsWritable: false},
+	}
+}
+
+type LockV1Param struct {
+	Mint       common.PublicKey
+	TokenOwner common.PublicKey
+	// Token defaults to the associated token account of TokenOwner
+	Token *common.PublicKey
+	// Authority is a utility or staking delegate, or the freeze authority of a fungible
+	Authority          common.PublicKey
+	Payer              common.PublicKey
+	TokenStandard      TokenStandard
+	AuthorizationRules *common.PublicKey
+	AuthorizationData  *AuthorizationData
+	// Metadata is the mint's metadata, a nil AuthorizationRules is filled with its rule set
+	Metadata       *Metadata
+	TokenProgramID *common.PublicKey
+}
+
+func LockV1(param LockV1Param) types.Instruction {
+	return types.Instruction{
+		ProgramID: common.MetaplexTokenMetaProgramID,
+		Accounts:  lockAccounts(param),
+		Data: serializeArgs(InstructionLock, 0, struct {
+			AuthorizationData *AuthorizationData
+		}{
+			AuthorizationData: param.AuthorizationData,
+		}),
+	}
+}
+
+type UnlockV1Param = LockV1Param
+
+func UnlockV1(param UnlockV1Param) types.Instruction {
+	return types.Instruction{
+		ProgramID: common.MetaplexTokenMetaProgramID,
+		Accounts:  lockAccounts(param),
+		Data: serializeArgs(InstructionUnlock, 0, struct {
+			AuthorizationData *AuthorizationData
+		}{
+			AuthorizationData: param.AuthorizationData,
+		}),
+	}
+}
+
+func lockAccounts(param LockV1Param) []types.AccountMeta {
+	token := tokenAccount(param.Token, param.TokenOwner, param.Mint, param.TokenProgramID)
+	rulesProgram, rules := authorizationRules(param.AuthorizationRules, param.Metadata)
+
+	return []types.AccountMeta{
+		{PubKey: param.Authority, IsSigner: true, IsWritable: false},
+		{PubKey: param.TokenOwner, IsSigner: false, IsWritable: false},
+		{PubKey: token, IsSigner: false, IsWritable: true},
+		{PubKey: param.Mint, IsSigner: false, IsWritable: false},
+		{PubKey: mustDerive(GetTokenMetaPubkey(param.Mint)), IsSigner: false, IsWritable: true},
+		{PubKey: edition(param.Mint, param.TokenStandard), IsSigner: false, IsWritable: false},
+		{PubKey: tokenRecord(param.Mint, token, param.TokenStandard), IsSigner: false, IsWritable: true},
+		{PubKey: param.Payer, IsSigner: true, IsWritable: true},
+		{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+		{PubKey: common.SysVarInstructionsPubkey, IsSigner: false, IsWritable: false},
+		{PubKey: tokenProgram(param.TokenProgramID), IsSigner: false, IsWritable: false},
+		{PubKey: rulesProgram, IsSigner: false, IsWritable: false},
+		{PubKey: rules, IsSigner: false, IsWritable: false},
+	}
+}
+
+type UpdateV1Param struct {
+	Mint common.PublicKey
+	// Authority is the update authority or a metadata delegate
+	Authority      common.PublicKey
+	Payer          common.PublicKey
+	DelegateRecord *common.PublicKey
+	// Token is the token account of a holder delegate
+	Token               *common.PublicKey
+	TokenStandard       TokenStandard
+	NewUpdateAuthority  *common.PublicKey
+	Data                *Data
+	PrimarySaleHappened *bool
+	IsMutable           *bool
+	Collection          CollectionToggle
+	CollectionDetails   CollectionDetailsToggle
+	Uses                UsesToggle
+	RuleSet             RuleSetToggle
+	AuthorizationRules  *common.PublicKey
+	AuthorizationData   *AuthorizationData
+	// Metadata is the mint's metadata, a nil AuthorizationRules is filled with its rule set
+	Metadata *Metadata
+}
+
+func UpdateV1(param UpdateV1Param) types.Instruction {
+	data := serializeArgs(InstructionUpdate, 0, struct {
+		NewUpdateAuthority  *common.PublicKey
+		Data                *Data
+		PrimarySaleHappened *bool
+		IsMutable           *bool
+		Collection          CollectionToggle
+		CollectionDetails   CollectionDetailsToggle
+		Uses                UsesToggle
+		RuleSet             RuleSetToggle
+		AuthorizationData   *AuthorizationData
+	}{
+		NewUpdateAuthority:  param.NewUpdateAuthority,
+		Data:                param.Data,
+		PrimarySaleHappened: param.PrimarySaleHappened,
+		IsMutable:           param.IsMutable,
+		Collection:          param.Collection,
+		CollectionDetails:   param.CollectionDetails,
+		Uses:                param.Uses,
+		RuleSet:             param.RuleSet,
+		AuthorizationData:   param.AuthorizationData,
+	})
+
+	rulesProgram, rules := authorizationRules(param.AuthorizationRules, param.Metadata)
+
+	return types.Instruction{
+		ProgramID: common.MetaplexTokenMetaProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: param.Authority, IsSigner: true, IsWritable: false},
+			{PubKey: optionalAccount(param.DelegateRecord), IsSigner: false, IsWritable: false},
+			{PubKey: optionalAccount(param.Token), IsSigner: false, IsWritable: false},
+			{PubKey: param.Mint, IsSigner: false, IsWritable: false},
+			{PubKey: mustDerive(GetTokenMetaPubkey(param.Mint)), IsSigner: false, IsWritable: true},
+			{PubKey: edition(param.Mint, param.TokenStandard), IsSigner: false, IsWritable: false},
+			{PubKey: param.Payer, IsSigner: true, IsWritable: true},
+			{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: common.SysVarInstructionsPubkey, IsSigner: false, IsWritable: false},
+			{PubKey: rulesProgram, IsSigner: false, IsWritable: false},
+			{PubKey: rules, IsSigner: false, IsWritable: false},
+		},
+		Data: data,
+	}
+}
+
+type UseV1Param struct {
+	Mint common.PublicKey
+	// Authority is the token owner or a use delegate
+	Authority common.PublicKey
+	Payer     common.PublicKey
+	// Token defaults to the associated token account of Authority
+	Token              *common.PublicKey
+	DelegateRecord     *common.PublicKey
+	TokenStandard      TokenStandard
+	AuthorizationRules *common.PublicKey
+	AuthorizationData  *AuthorizationData
+	// Metadata is the mint's metadata, a nil AuthorizationRules is filled with its rule set
+	Metadata       *Metadata
+	TokenProgramID *common.PublicKey
+}
+
+func UseV1(param UseV1Param) types.Instruction {
+	data := serializeArgs(InstructionUse, 0, struct {
+		AuthorizationData *AuthorizationData
+	}{
+		AuthorizationData: param.AuthorizationData,
+	})
+
+	rulesProgram, rules := authorizationRules(param.AuthorizationRules, param.Metadata)
+
+	return types.Instruction{
+		ProgramID: common.MetaplexTokenMetaProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: param.Authority, IsSigner: true, IsWritable: false},
+			{PubKey: optionalAccount(param.DelegateRecord), IsSigner: false, IsWritable: true},
+			{PubKey: tokenAccount(param.Token, param.Authority, param.Mint, param.TokenProgramID), IsSigner: false, IsWritable: true},
+			{PubKey: param.Mint, IsSigner: false, IsWritable: false},
+			{PubKey: mustDerive(GetTokenMetaPubkey(param.Mint)), IsSigner: false, IsWritable: true},
+			{PubKey: edition(param.Mint, param.TokenStandard), IsSigner: false, IsWritable: true},
+			{PubKey: param.Payer, IsSigner: true, IsWritable: true},
+			{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: common.SysVarInstructionsPubkey, IsSigner: false, IsWritable: false},
+			{PubKey: tokenProgram(param.TokenProgramID), IsSigner: false, IsWritable: false},
+			{PubKey: rulesProgram, IsSigner: false, IsWritable: false},
+			{PubKey: rules, IsSigner: false, IsWritable: false},
+		},
+		Data: data,
+	}
+}
+
+type VerifyCreatorV1Param struct {
+	Mint    common.PublicKey
+	Creator common.PublicKey
+}
+
+func VerifyCreatorV1(param VerifyCreatorV1Param) types.Instruction {
+	return types.Instruction{
+		ProgramID: common.MetaplexTokenMetaProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: param.Creator, IsSigner: true, IsWritable: false},
+			{PubKey: common.MetaplexTokenMetaProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: mustDerive(GetTokenMetaPubkey(param.Mint)), IsSigner: false, IsWritable: true},
+			{PubKey: common.MetaplexTokenMetaProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: common.MetaplexTokenMetaProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: common.MetaplexTokenMetaProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: common.SysVarInstructionsPubkey, IsSigner: false, IsWritable: false},
+		},
+		Data: serializeArgs(InstructionVerify, VerificationArgsCreatorV1, struct{}{}),
+	}
+}
+
+type VerifyCollectionV1Param struct {
+	Mint           common.PublicKey
+	CollectionMint common.PublicKey
+	// Authority is the collection's update authority or a collection delegate
+	Authority      common.PublicKey
+	DelegateRecord *common.PublicKey
+}
+
+func VerifyCollectionV1(param VerifyCollectionV1Param) types.Instruction {
+	return types.Instruction{
+		ProgramID: common.MetaplexTokenMetaProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: param.Authority, IsSigner: true, IsWritable: false},
+			{PubKey: optionalAccount(param.DelegateRecord), IsSigner: false, IsWritable: false},
+			{PubKey: mustDerive(GetTokenMetaPubkey(param.Mint)), IsSigner: false, IsWritable: true},
+			{PubKey: param.CollectionMint, IsSigner: false, IsWritable: false},
+			{PubKey: mustDerive(GetTokenMetaPubkey(param.CollectionMint)), IsSigner: false, IsWritable: true},
+			{PubKey: mustDerive(GetMasterEdition(param.CollectionMint)), IsSigner: false, IsWritable: false},
+			{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: common.SysVarInstructionsPubkey, IsSigner: false, IsWritable: false},
+		},
+		Data: serializeArgs(InstructionVerify, VerificationArgsCollectionV1, struct{}{}),
+	}
+}
+
+type UnverifyCreatorV1Param = VerifyCreatorV1Param
+
+func UnverifyCreatorV1(param UnverifyCreatorV1Param) types.Instruction {
+	return types.Instruction{
+		ProgramID: common.MetaplexTokenMetaProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: param.Creator, IsSigner: true, IsWritable: false},
+			{PubKey: common.MetaplexTokenMetaProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: mustDerive(GetTokenMetaPubkey(param.Mint)), IsSigner: false, IsWritable: true},
+			{PubKey: common.MetaplexTokenMetaProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: common.MetaplexTokenMetaProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: common.SysVarInstructionsPubkey, IsSigner: false, IsWritable: false},
+		},
+		Data: serializeArgs(InstructionUnverify, VerificationArgsCreatorV1, struct{}{}),
+	}
+}
+
+type UnverifyCollectionV1Param = VerifyCollectionV1Param
+
+func UnverifyCollectionV1(param UnverifyCollectionV1Param) types.Instruction {
+	return types.Instruction{
+		ProgramID: common.MetaplexTokenMetaProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: param.Authority, IsSigner: true, IsWritable: false},
+			{PubKey: optionalAccount(param.DelegateRecord), IsSigner: false, IsWritable: false},
+			{PubKey: mustDerive(GetTokenMetaPubkey(param.Mint)), IsSigner: false, IsWritable: true},
+			{PubKey: param.CollectionMint, IsSigner: false, IsWritable: false},
+			{PubKey: mustDerive(GetTokenMetaPubkey(param.CollectionMint)), IsSigner: false, IsWritable: true},
+			{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: common.SysVarInstructionsPubkey, IsSigner: false, IsWritable: false},
+		},
+		Data: serializeArgs(InstructionUnverify, VerificationArgsCollectionV1, struct{}{}),
+	}
+}
+
+// serializeArgs writes the instruction, the variant of its versioned args and the variant's fields
+func serializeArgs(instruction Instruction, variant uint8, fields any) []byte {
+	data, err := borsh.Serialize(fields)
+	if err != nil {
+		panic(err)
+	}
+	return append([]byte{uint8(instruction), variant}, data...)
+}
+
+// optionalAccount fills an optional account slot, the program reads its own id as none
+func optionalAccount(account *common.PublicKey) common.PublicKey {
+	if account == nil {
+		return common.MetaplexTokenMetaProgramID
+	}
+	return *account
+}
+
+func tokenProgram(tokenProgramID *common.PublicKey) common.PublicKey {
+	if tokenProgramID == nil {
+		return common.TokenProgramID
+	}
+	return *tokenProgramID
+}
+
+func tokenAccount(token *common.PublicKey, owner, mint common.PublicKey, tokenProgramID *common.PublicKey) common.PublicKey {
+	if token != nil {
+		return *token
+	}
+	ata, _, err := associated_token_account.GetAssociatedTokenAddress(owner, mint, tokenProgram(tokenProgramID))
+	return mustDerive(ata, err)
+}
+
+// edition is the master edition or the print edition of a non fungible
+func edition(mint common.PublicKey, tokenStandard TokenStandard) common.PublicKey {
+	switch tokenStandard {
+	case NonFungible, NonFungibleEdition, ProgrammableNonFungible:
+		return mustDerive(GetMasterEdition(mint))
+	}
+	return common.MetaplexTokenMetaProgramID
+}
+
+func tokenRecord(mint, token common.PublicKey, tokenStandard TokenStandard) common.PublicKey {
+	if tokenStandard != ProgrammableNonFungible {
+		return common.MetaplexTokenMetaProgramID
+	}
+	return mustDerive(GetTokenRecord(mint, token))
+}
+
+// authorizationRules is the rules program and the rule set, a nil rules falls back to the metadata's rule set
+func authorizationRules(rules *common.PublicKey, metadata *Metadata) (common.PublicKey, common.PublicKey) {
+	if rules == nil && metadata != nil {
+		rules = metadata.RuleSet()
+	}
+	if rules == nil {
+		return common.MetaplexTokenMetaProgramID, common.MetaplexTokenMetaProgramID
+	}
+	return common.MetaplexTokenAuthRulesProgramID, *rules
+}
+
+// mustDerive panics on a failed derivation, like the builders do on a failed serialization
+func mustDerive(pubkey common.PublicKey, err error) common.PublicKey {
+	if err != nil {
+		panic(err)
+	}
+	return pubkey
+}
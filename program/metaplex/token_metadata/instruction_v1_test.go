@@ -0,0 +1,457 @@
+package token_metadata
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/qimeila/solana-go-sdk/common"
+	"github.com/qimeila/solana-go-sdk/pkg/pointer"
+	"github.com/qimeila/solana-go-sdk/program/associated_token_account"
+	"github.com/qimeila/solana-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	testMint        = common.PublicKeyFromString("GphF2vTuzhwhLWBWWvD8y5QLCPp1aQC5EnzrWsnbiWPx")
+	testOwner       = common.PublicKeyFromString("9BKWqDHfHZh9j39xakYVMdr6hXmCLHH5VfCpeq2idU9L")
+	testReceiver    = common.PublicKeyFromString("HNGVuL5kqjDehw7KR63w9gxow32sX6xzRNgLb8GkbwCM")
+	testPayer       = common.PublicKeyFromString("9FYsKrNuEweb55Wa2jaj8wTKYDBvuCG3huhakEj96iN9")
+	testRuleSet     = common.PublicKeyFromString("eBJLFYPxJmMGKuFwpDWkzxZeUrad92kZRC5BJLpzyT9")
+	testMetadata    = mustDerive(GetTokenMetaPubkey(testMint))
+	testEdition     = mustDerive(GetMasterEdition(testMint))
+	testOwnerAta    = testAta(testOwner)
+	testReceiverAta = testAta(testReceiver)
+)
+
+func testAta(owner common.PublicKey) common.PublicKey {
+	ata, _, err := associated_token_account.GetAssociatedTokenAddress(owner, testMint, common.TokenProgramID)
+	return mustDerive(ata, err)
+}
+
+func u64le(v uint64) []byte {
+	return binary.LittleEndian.AppendUint64(nil, v)
+}
+
+func TestTransferV1(t *testing.T) {
+	tests := []struct {
+		name  string
+		param TransferV1Param
+		want  types.Instruction
+	}{
+		{
+			name: "programmable non fungible",
+			param: TransferV1Param{
+				Mint:               testMint,
+				TokenOwner:         testOwner,
+				DestinationOwner:   testReceiver,
+				Authority:          testOwner,
+				Payer:              testPayer,
+				Amount:             1,
+				TokenStandard:      ProgrammableNonFungible,
+				AuthorizationRules: &testRuleSet,
+			},
+			want: types.Instruction{
+				ProgramID: common.MetaplexTokenMetaProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: testOwnerAta, IsSigner: false, IsWritable: true},
+					{PubKey: testOwner, IsSigner: false, IsWritable: false},
+					{PubKey: testReceiverAta, IsSigner: false, IsWritable: true},
+					{PubKey: testReceiver, IsSigner: false, IsWritable: false},
+					{PubKey: testMint, IsSigner: false, IsWritable: false},
+					{PubKey: testMetadata, IsSigner: false, IsWritable: true},
+					{PubKey: testEdition, IsSigner: false, IsWritable: false},
+					{PubKey: mustDerive(GetTokenRecord(testMint, testOwnerAta)), IsSigner: false, IsWritable: true},
+					{PubKey: mustDerive(GetTokenRecord(testMint, testReceiverAta)), IsSigner: false, IsWritable: true},
+					{PubKey: testOwner, IsSigner: true, IsWritable: false},
+					{PubKey: testPayer, IsSigner: true, IsWritable: true},
+					{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+					{PubKey: common.SysVarInstructionsPubkey, IsSigner: false, IsWritable: false},
+					{PubKey: common.TokenProgramID, IsSigner: false, IsWritable: false},
+					{PubKey: common.SPLAssociatedTokenAccountProgramID, IsSigner: false, IsWritable: false},
+					{PubKey: common.MetaplexTokenAuthRulesProgramID, IsSigner: false, IsWritable: false},
+					{PubKey: testRuleSet, IsSigner: false, IsWritable: false},
+				},
+				Data: append(append([]byte{49, 0}, u64le(1)...), 0),
+			},
+		},
+		{
+			name: "fungible",
+			param: TransferV1Param{
+				Mint:             testMint,
+				TokenOwner:       testOwner,
+				DestinationOwner: testReceiver,
+				Destination:      &testReceiver,
+				Authority:        testOwner,
+				Payer:            testOwner,
+				Amount:           1000,
+				TokenStandard:    Fungible,
+			},
+			want: types.Instruction{
+				ProgramID: common.MetaplexTokenMetaProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: testOwnerAta, IsSigner: false, IsWritable: true},
+					{PubKey: testOwner, IsSigner: false, IsWritable: false},
+					{PubKey: testReceiver, IsSigner: false, IsWritable: true},
+					{PubKey: testReceiver, IsSigner: false, IsWritable: false},
+					{PubKey: testMint, IsSigner: false, IsWritable: false},
+					{PubKey: testMetadata, IsSigner: false, IsWritable: true},
+					{PubKey: common.MetaplexTokenMetaProgramID, IsSigner: false, IsWritable: false},
+					{PubKey: common.MetaplexTokenMetaProgramID, IsSigner: false, IsWritable: true},
+					{PubKey: common.MetaplexTokenMetaProgramID, IsSigner: false, IsWritable: true},
+					{PubKey: testOwner, IsSigner: true, IsWritable: false},
+					{PubKey: testOwner, IsSigner: true, IsWritable: true},
+					{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+					{PubKey: common.SysVarInstructionsPubkey, IsSigner: false, IsWritable: false},
+					{PubKey: common.TokenProgramID, IsSigner: false, IsWritable: false},
+					{PubKey: common.SPLAssociatedTokenAccountProgramID, IsSigner: false, IsWritable: false},
+					{PubKey: common.MetaplexTokenMetaProgramID, IsSigner: false, IsWritable: false},
+					{PubKey: common.MetaplexTokenMetaProgramID, IsSigner: false, IsWritable: false},
+				},
+				Data: append(append([]byte{49, 0}, u64le(1000)...), 0),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, TransferV1(tt.param))
+		})
+	}
+}
+
+func TestCreateV1(t *testing.T) {
+	got := CreateV1(CreateV1Param{
+		Mint:                    testMint,
+		MintIsSigner:            true,
+		MintAuthority:           testOwner,
+		Payer:                   testPayer,
+		UpdateAuthority:         testOwner,
+		UpdateAuthorityIsSigner: true,
+		AssetData: AssetData{
+			Name:                 "A",
+			Symbol:               "B",
+			Uri:                  "C",
+			SellerFeeBasisPoints: 500,
+			IsMutable:            true,
+			TokenStandard:        ProgrammableNonFungible,
+			RuleSet:              &testRuleSet,
+		},
+		Decimals:    pointer.Get[uint8](0),
+		PrintSupply: &PrintSupply{Enum: PrintSupplyLimited, Limited: LimitedPrintSupply{MaxSupply: 10}},
+	})
+
+	data := []byte{42, 0}
+	data = append(data, 1, 0, 0, 0, 'A', 1, 0, 0, 0, 'B', 1, 0, 0, 0, 'C')
+	data = append(data, 0xf4, 0x01) // seller fee basis points
+	data = append(data, 0, 0, 1, 4) // creators, primary sale happened, is mutable, token standard
+	data = append(data, 0, 0, 0)    // collection, uses, collection details
+	data = append(data, 1)
+	data = append(data, testRuleSet.Bytes()...)
+	data = append(data, 1, 0) // decimals
+	data = append(data, 1, 1) // print supply
+	data = append(data, u64le(10)...)
+
+	assert.Equal(t, types.Instruction{
+		ProgramID: common.MetaplexTokenMetaProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: testMetadata, IsSigner: false, IsWritable: true},
+			{PubKey: testEdition, IsSigner: false, IsWritable: true},
+			{PubKey: testMint, IsSigner: true, IsWritable: true},
+			{PubKey: testOwner, IsSigner: true, IsWritable: false},
+			{PubKey: testPayer, IsSigner: true, IsWritable: true},
+			{PubKey: testOwner, IsSigner: true, IsWritable: false},
+			{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: common.SysVarInstructionsPubkey, IsSigner: false, IsWritable: false},
+			{PubKey: common.TokenProgramID, IsSigner: false, IsWritable: false},
+		},
+		Data: data,
+	}, got)
+}
+
+func TestMintV1(t *testing.T) {
+	got := MintV1(MintV1Param{
+		Mint:          testMint,
+		TokenOwner:    testOwner,
+		Authority:     testOwner,
+		Payer:         testPayer,
+		Amount:        1,
+		TokenStandard: ProgrammableNonFungible,
+	})
+	assert.Equal(t, append(append([]byte{43, 0}, u64le(1)...), 0), got.Data)
+	assert.Len(t, got.Accounts, 15)
+	assert.Equal(t, testOwnerAta, got.Accounts[0].PubKey)
+	assert.Equal(t, testEdition, got.Accounts[3].PubKey)
+	assert.Equal(t, mustDerive(GetTokenRecord(testMint, testOwnerAta)), got.Accounts[4].PubKey)
+	assert.Equal(t, common.MetaplexTokenMetaProgramID, got.Accounts[7].PubKey)
+	assert.Equal(t, common.SPLAssociatedTokenAccountProgramID, got.Accounts[12].PubKey)
+}
+
+func TestDelegate(t *testing.T) {
+	tokenRecord := mustDerive(GetTokenRecord(testMint, testOwnerAta))
+	collectionRecord := mustDerive(GetDelegateRecord(testMint, DelegateRoleCollection, testOwner, testReceiver))
+
+	tests := []struct {
+		name               string
+		param              DelegateParam
+		wantDelegateData   []byte
+		wantRevokeData     []byte
+		wantDelegateRecord common.PublicKey
+		wantTokenRecord    common.PublicKey
+		wantToken          common.PublicKey
+		wantTokenProgram   common.PublicKey
+	}{
+		{
+			name: "transfer delegate of a pnft",
+			param: DelegateParam{
+				Role:          DelegateRoleTransfer,
+				Mint:          testMint,
+				Delegate:      testReceiver,
+				Authority:     testOwner,
+				Payer:         testOwner,
+				TokenStandard: ProgrammableNonFungible,
+				Amount:        1,
+			},
+			wantDelegateData:   append(append([]byte{44, 2}, u64le(1)...), 0),
+			wantRevokeData:     []byte{45, 2},
+			wantDelegateRecord: common.MetaplexTokenMetaProgramID,
+			wantTokenRecord:    tokenRecord,
+			wantToken:          testOwnerAta,
+			wantTokenProgram:   common.TokenProgramID,
+		},
+		{
+			name: "standard delegate",
+			param: DelegateParam{
+				Role:          DelegateRoleStandard,
+				Mint:          testMint,
+				Delegate:      testReceiver,
+				Authority:     testOwner,
+				Payer:         testOwner,
+				TokenStandard: Fungible,
+				Amount:        7,
+			},
+			wantDelegateData:   append([]byte{44, 6}, u64le(7)...),
+			wantRevokeData:     []byte{45, 6},
+			wantDelegateRecord: common.MetaplexTokenMetaProgramID,
+			wantTokenRecord:    common.MetaplexTokenMetaProgramID,
+			wantToken:          testOwnerAta,
+			wantTokenProgram:   common.TokenProgramID,
+		},
+		{
+			name: "collection delegate",
+			param: DelegateParam{
+				Role:          DelegateRoleCollection,
+				Mint:          testMint,
+				Delegate:      testReceiver,
+				Authority:     testOwner,
+				Payer:         testOwner,
+				TokenStandard: NonFungible,
+			},
+			wantDelegateData:   []byte{44, 0, 0},
+			wantRevokeData:     []byte{45, 0},
+			wantDelegateRecord: collectionRecord,
+			wantTokenRecord:    common.MetaplexTokenMetaProgramID,
+			wantToken:          common.MetaplexTokenMetaProgramID,
+			wantTokenProgram:   common.MetaplexTokenMetaProgramID,
+		},
+		{
+			name: "authority item delegate",
+			param: DelegateParam{
+				Role:          DelegateRoleAuthorityItem,
+				Mint:          testMint,
+				Delegate:      testReceiver,
+				Authority:     testOwner,
+				Payer:         testOwner,
+				TokenStandard: NonFungible,
+			},
+			wantDelegateData:   []byte{44, 9, 0},
+			wantRevokeData:     []byte{45, 10},
+			wantDelegateRecord: mustDerive(GetDelegateRecord(testMint, DelegateRoleAuthorityItem, testOwner, testReceiver)),
+			wantTokenRecord:    common.MetaplexTokenMetaProgramID,
+			wantToken:          common.MetaplexTokenMetaProgramID,
+			wantTokenProgram:   common.MetaplexTokenMetaProgramID,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, got := range []types.Instruction{Delegate(tt.param), Revoke(tt.param)} {
+				assert.Len(t, got.Accounts, 14)
+				assert.Equal(t, tt.wantDelegateRecord, got.Accounts[0].PubKey)
+				assert.Equal(t, tt.param.Delegate, got.Accounts[1].PubKey)
+				assert.Equal(t, testMetadata, got.Accounts[2].PubKey)
+				assert.Equal(t, tt.wantTokenRecord, got.Accounts[4].PubKey)
+				assert.Equal(t, tt.wantToken, got.Accounts[6].PubKey)
+				assert.Equal(t, types.AccountMeta{PubKey: testOwner, IsSigner: true, IsWritable: false}, got.Accounts[7])
+				assert.Equal(t, tt.wantTokenProgram, got.Accounts[11].PubKey)
+			}
+			assert.Equal(t, tt.wantDelegateData, Delegate(tt.param).Data)
+			assert.Equal(t, tt.wantRevokeData, Revoke(tt.param).Data)
+		})
+	}
+}
+
+func TestUpdateV1(t *testing.T) {
+	got := UpdateV1(UpdateV1Param{
+		Mint:          testMint,
+		Authority:     testOwner,
+		Payer:         testOwner,
+		TokenStandard: ProgrammableNonFungible,
+		IsMutable:     pointer.Get(false),
+		Collection:    CollectionToggle{Enum: ToggleSet, Set: Collection{Key: testReceiver}},
+		Uses:          UsesToggle{Enum: ToggleClear},
+	})
+
+	data := []byte{50, 0}
+	data = append(data, 0, 0, 0) // new update authority, data, primary sale happened
+	data = append(data, 1, 0)    // is mutable
+	data = append(data, 2, 0)    // collection
+	data = append(data, testReceiver.Bytes()...)
+	data = append(data, 0, 1, 0, 0) // collection details, uses, rule set, authorization data
+	assert.Equal(t, data, got.Data)
+	assert.Len(t, got.Accounts, 11)
+	assert.Equal(t, testMetadata, got.Accounts[4].PubKey)
+	assert.Equal(t, testEdition, got.Accounts[5].PubKey)
+}
+
+func TestLockV1(t *testing.T) {
+	for _, f := range []func(LockV1Param) types.Instruction{LockV1, UnlockV1} {
+		got := f(LockV1Param{
+			Mint:          testMint,
+			TokenOwner:    testOwner,
+			Authority:     testReceiver,
+			Payer:         testReceiver,
+			TokenStandard: ProgrammableNonFungible,
+		})
+		assert.Len(t, got.Accounts, 13)
+		assert.Equal(t, testOwnerAta, got.Accounts[2].PubKey)
+		assert.Equal(t, mustDerive(GetTokenRecord(testMint, testOwnerAta)), got.Accounts[6].PubKey)
+	}
+	assert.Equal(t, []byte{46, 0, 0}, LockV1(LockV1Param{Mint: testMint}).Data)
+	assert.Equal(t, []byte{47, 0, 0}, UnlockV1(UnlockV1Param{Mint: testMint}).Data)
+}
+
+func TestAuthorizationRulesFromMetadata(t *testing.T) {
+	pnft := &Metadata{
+		Mint:               testMint,
+		TokenStandard:      pointer.Get(ProgrammableNonFungible),
+		ProgrammableConfig: &ProgrammableConfig{V1: ProgrammableConfigV1{RuleSet: &testRuleSet}},
+	}
+	assert.Equal(t, &testRuleSet, pnft.RuleSet())
+	assert.Nil(t, Metadata{}.RuleSet())
+	assert.Nil(t, Metadata{ProgrammableConfig: &ProgrammableConfig{}}.RuleSet())
+
+	rulesAccounts := func(accounts []types.AccountMeta) []common.PublicKey {
+		return []common.PublicKey{accounts[len(accounts)-2].PubKey, accounts[len(accounts)-1].PubKey}
+	}
+	want := []common.PublicKey{common.MetaplexTokenAuthRulesProgramID, testRuleSet}
+
+	assert.Equal(t, want, rulesAccounts(TransferV1(TransferV1Param{
+		Mint:             testMint,
+		TokenOwner:       testOwner,
+		DestinationOwner: testReceiver,
+		Authority:        testOwner,
+		Payer:            testPayer,
+		Amount:           1,
+		TokenStandard:    ProgrammableNonFungible,
+		Metadata:         pnft,
+	}).Accounts))
+	assert.Equal(t, want, rulesAccounts(Delegate(DelegateParam{
+		Role:          DelegateRoleTransfer,
+		Mint:          testMint,
+		Delegate:      testReceiver,
+		Authority:     testOwner,
+		Payer:         testPayer,
+		TokenStandard: ProgrammableNonFungible,
+		Amount:        1,
+		Metadata:      pnft,
+	}).Accounts))
+	assert.Equal(t, want, rulesAccounts(LockV1(LockV1Param{
+		Mint:          testMint,
+		TokenOwner:    testOwner,
+		Authority:     testReceiver,
+		Payer:         testReceiver,
+		TokenStandard: ProgrammableNonFungible,
+		Metadata:      pnft,
+	}).Accounts))
+	assert.Equal(t, want, rulesAccounts(UpdateV1(UpdateV1Param{
+		Mint:          testMint,
+		Authority:     testOwner,
+		Payer:         testOwner,
+		TokenStandard: ProgrammableNonFungible,
+		Metadata:      pnft,
+	}).Accounts))
+
+	// an explicit rule set wins, metadata without one leaves the slots empty
+	other := common.PublicKeyFromString("27kVX7JpPZ1bsrSckbR76mV6GeRqtrjoddubfg2zBpHZ")
+	assert.Equal(t, []common.PublicKey{common.MetaplexTokenAuthRulesProgramID, other}, rulesAccounts(LockV1(LockV1Param{
+		Mint:               testMint,
+		TokenStandard:      ProgrammableNonFungible,
+		AuthorizationRules: &other,
+		Metadata:           pnft,
+	}).Accounts))
+	assert.Equal(t, []common.PublicKey{common.MetaplexTokenMetaProgramID, common.MetaplexTokenMetaProgramID}, rulesAccounts(LockV1(LockV1Param{
+		Mint:          testMint,
+		TokenStandard: ProgrammableNonFungible,
+		Metadata:      &Metadata{Mint: testMint},
+	}).Accounts))
+}
+
+func TestVerifyV1(t *testing.T) {
+	collectionMint := testReceiver
+
+	got := VerifyCollectionV1(VerifyCollectionV1Param{Mint: testMint, CollectionMint: collectionMint, Authority: testOwner})
+	assert.Equal(t, []byte{52, 1}, got.Data)
+	assert.Equal(t, []types.AccountMeta{
+		{PubKey: testOwner, IsSigner: true, IsWritable: false},
+		{PubKey: common.MetaplexTokenMetaProgramID, IsSigner: false, IsWritable: false},
+		{PubKey: testMetadata, IsSigner: false, IsWritable: true},
+		{PubKey: collectionMint, IsSigner: false, IsWritable: false},
+		{PubKey: mustDerive(GetTokenMetaPubkey(collectionMint)), IsSigner: false, IsWritable: true},
+		{PubKey: mustDerive(GetMasterEdition(collectionMint)), IsSigner: false, IsWritable: false},
+		{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+		{PubKey: common.SysVarInstructionsPubkey, IsSigner: false, IsWritable: false},
+	}, got.Accounts)
+
+	got = UnverifyCollectionV1(UnverifyCollectionV1Param{Mint: testMint, CollectionMint: collectionMint, Authority: testOwner})
+	assert.Equal(t, []byte{53, 1}, got.Data)
+	assert.Len(t, got.Accounts, 7)
+
+	assert.Equal(t, []byte{52, 0}, VerifyCreatorV1(VerifyCreatorV1Param{Mint: testMint, Creator: testOwner}).Data)
+	assert.Equal(t, []byte{53, 0}, UnverifyCreatorV1(UnverifyCreatorV1Param{Mint: testMint, Creator: testOwner}).Data)
+}
+
+func TestAuthorizationData(t *testing.T) {
+	got := UseV1(UseV1Param{
+		Mint:      testMint,
+		Authority: testOwner,
+		Payer:     testOwner,
+		AuthorizationData: &AuthorizationData{
+			Payload: Payload{
+				Map: map[string]PayloadType{
+					"Destination": {Enum: PayloadTypePubkey, Pubkey: PayloadPubkey{Pubkey: testReceiver}},
+					"Amount":      {Enum: PayloadTypeNumber, Number: PayloadNumber{Number: 1}},
+				},
+			},
+		},
+	})
+
+	data := []byte{51, 0, 1, 2, 0, 0, 0}
+	data = append(data, 6, 0, 0, 0)
+	data = append(data, "Amount"...)
+	data = append(data, 3)
+	data = append(data, u64le(1)...)
+	data = append(data, 11, 0, 0, 0)
+	data = append(data, "Destination"...)
+	data = append(data, 0)
+	data = append(data, testReceiver.Bytes()...)
+	assert.Equal(t, data, got.Data)
+}
+
+func TestGetDelegateRecord(t *testing.T) {
+	_, err := GetDelegateRecord(testMint, DelegateRoleSale, testOwner, testReceiver)
+	assert.Error(t, err)
+
+	collection, err := GetDelegateRecord(testMint, DelegateRoleCollection, testOwner, testReceiver)
+	assert.NoError(t, err)
+	data, err := GetDelegateRecord(testMint, DelegateRoleData, testOwner, testReceiver)
+	assert.NoError(t, err)
+	assert.NotEqual(t, collection, data)
+}
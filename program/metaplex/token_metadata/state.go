@@ -4,8 +4,8 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/near/borsh-go"
 	"github.com/qimeila/solana-go-sdk/common"
+	"github.com/qimeila/solana-go-sdk/pkg/borsh"
 )
 
 const EDITION_MARKER_BIT_SIZE uint64 = 248
@@ -23,6 +23,11 @@ const (
 	KeyEditionMarker
 	KeyUseAuthorityRecord
 	KeyCollectionAuthorityRecord
+	KeyTokenOwnedEscrow
+	KeyTokenRecord
+	KeyMetadataDelegate
+	KeyEditionMarkerV2
+	KeyHolderDelegate
 )
 
 type Creator struct {
@@ -104,7 +109,7 @@ const (
 )
 
 type CollectionDetails struct {
-	Enum borsh.Enum `borsh_enum:"true"`
+	Enum borsh.Enum `borsh:"enum"`
 	V1   CollectionDetailsV1
 }
 
@@ -113,7 +118,7 @@ type CollectionDetailsV1 struct {
 }
 
 type ProgrammableConfig struct {
-	Enum borsh.Enum `borsh_enum:"true"`
+	Enum borsh.Enum `borsh:"enum"`
 	V1   ProgrammableConfigV1
 }
 
@@ -121,15 +126,23 @@ type ProgrammableConfigV1 struct {
 	RuleSet *common.PublicKey
 }
 
+// RuleSet returns the authorization rule set of a programmable nft, nil if it has none
+func (m Metadata) RuleSet() *common.PublicKey {
+	if m.ProgrammableConfig == nil {
+		return nil
+	}
+	return m.ProgrammableConfig.V1.RuleSet
+}
+
 func MetadataDeserialize(data []byte) (Metadata, error) {
 	var metadata Metadata
-	err := borsh.Deserialize(&metadata, data)
+	err := borsh.Deserialize(data, &metadata)
 	if err != nil {
 		// https://github.com/samuelvanderwaal/metaboss/issues/121
 		// https://github.com/metaplex-foundation/metaplex-program-library/pull/407
 		// C.f. https://github.com/metaplex-foundation/metaplex-program-library/blob/master/token-metadata/program/src/deser.rs#L12
 		var metadataPreV11 metadataPreV11
-		err := borsh.Deserialize(&metadataPreV11, data)
+		err := borsh.Deserialize(data, &metadataPreV11)
 		if err != nil {
 			return Metadata{}, fmt.Errorf("failed to deserialize data, err: %v", err)
 		} else {
@@ -154,3 +167,185 @@ type MasterEditionV2 struct {
 	Supply    uint64
 	MaxSupply *uint64
 }
+
+// Edition is a print of a master edition
+type Edition struct {
+	Key Key
+	// Parent is the master edition account
+	Parent  common.PublicKey
+	Edition uint64
+}
+
+// EditionMarker records which of 248 editions of a master edition are printed,
+// the marker of edition n is derived by GetEditionMark
+type EditionMarker struct {
+	Key    Key
+	Ledger [31]uint8
+}
+
+// EditionTaken reports whether the edition is printed, the marker must be the one of the edition
+func (m EditionMarker) EditionTaken(edition uint64) bool {
+	offset := edition % EDITION_MARKER_BIT_SIZE
+	return m.Ledger[offset/8]&(1<<(7-offset%8)) != 0
+}
+
+// EditionMarkerV2 records the printed editions of a programmable master edition in one account
+type EditionMarkerV2 struct {
+	Key    Key
+	Ledger []uint8
+}
+
+func (m EditionMarkerV2) EditionTaken(edition uint64) bool {
+	index := edition / 8
+	if index >= uint64(len(m.Ledger)) {
+		return false
+	}
+	return m.Ledger[index]&(1<<(7-edition%8)) != 0
+}
+
+type TokenState borsh.Enum
+
+const (
+	TokenStateUnlocked TokenState = iota
+	TokenStateLocked
+	TokenStateListed
+)
+
+type TokenDelegateRole borsh.Enum
+
+const (
+	TokenDelegateRoleSale TokenDelegateRole = iota
+	TokenDelegateRoleTransfer
+	TokenDelegateRoleUtility
+	TokenDelegateRoleStaking
+	TokenDelegateRoleStandard
+	TokenDelegateRoleLockedTransfer
+	TokenDelegateRoleMigration
+)
+
+// TokenRecord keeps the state and the delegate of a programmable nft's token account
+type TokenRecord struct {
+	Key             Key
+	Bump            uint8
+	State           TokenState
+	RuleSetRevision *uint64
+	Delegate        *common.PublicKey
+	DelegateRole    *TokenDelegateRole
+	LockedTransfer  *common.PublicKey
+}
+
+type CollectionAuthorityRecord struct {
+	Key             Key
+	Bump            uint8
+	UpdateAuthority *common.PublicKey
+}
+
+type UseAuthorityRecord struct {
+	Key         Key
+	AllowedUses uint64
+	Bump        uint8
+}
+
+// MetadataDelegateRecord is the record of a metadata delegate, the role is in the account's seeds
+type MetadataDelegateRecord struct {
+	Key             Key
+	Bump            uint8
+	Mint            common.PublicKey
+	Delegate        common.PublicKey
+	UpdateAuthority common.PublicKey
+}
+
+// HolderDelegateRecord is the record of a print delegate approved by the token owner
+type HolderDelegateRecord struct {
+	Key             Key
+	Bump            uint8
+	Mint            common.PublicKey
+	Delegate        common.PublicKey
+	UpdateAuthority common.PublicKey
+}
+
+func MasterEditionV2Deserialize(data []byte) (MasterEditionV2, error) {
+	return deserializeAccount[MasterEditionV2](data, KeyMasterEditionV2)
+}
+
+func EditionDeserialize(data []byte) (Edition, error) {
+	return deserializeAccount[Edition](data, KeyEditionV1)
+}
+
+func EditionMarkerDeserialize(data []byte) (EditionMarker, error) {
+	return deserializeAccount[EditionMarker](data, KeyEditionMarker)
+}
+
+func EditionMarkerV2Deserialize(data []byte) (EditionMarkerV2, error) {
+	return deserializeAccount[EditionMarkerV2](data, KeyEditionMarkerV2)
+}
+
+func TokenRecordDeserialize(data []byte) (TokenRecord, error) {
+	return deserializeAccount[TokenRecord](data, KeyTokenRecord)
+}
+
+func CollectionAuthorityRecordDeserialize(data []byte) (CollectionAuthorityRecord, error) {
+	return deserializeAccount[CollectionAuthorityRecord](data, KeyCollectionAuthorityRecord)
+}
+
+func UseAuthorityRecordDeserialize(data []byte) (UseAuthorityRecord, error) {
+	return deserializeAccount[UseAuthorityRecord](data, KeyUseAuthorityRecord)
+}
+
+func MetadataDelegateRecordDeserialize(data []byte) (MetadataDelegateRecord, error) {
+	return deserializeAccount[MetadataDelegateRecord](data, KeyMetadataDelegate)
+}
+
+func HolderDelegateRecordDeserialize(data []byte) (HolderDelegateRecord, error) {
+	return deserializeAccount[HolderDelegateRecord](data, KeyHolderDelegate)
+}
+
+// Deserialize decodes any token metadata account by its key. The result is one of
+// Metadata, MasterEditionV2, Edition, EditionMarker, EditionMarkerV2, TokenRecord,
+// CollectionAuthorityRecord, UseAuthorityRecord, MetadataDelegateRecord and HolderDelegateRecord.
+func Deserialize(data []byte, accountOwner common.PublicKey) (any, error) {
+	if accountOwner != common.MetaplexTokenMetaProgramID {
+		return nil, ErrInvalidAccountOwner
+	}
+	if len(data) == 0 {
+		return nil, ErrInvalidAccountDataSize
+	}
+
+	switch Key(data[0]) {
+	case KeyMetadataV1:
+		return MetadataDeserialize(data)
+	case KeyMasterEditionV2:
+		return MasterEditionV2Deserialize(data)
+	case KeyEditionV1:
+		return EditionDeserialize(data)
+	case KeyEditionMarker:
+		return EditionMarkerDeserialize(data)
+	case KeyEditionMarkerV2:
+		return EditionMarkerV2Deserialize(data)
+	case KeyTokenRecord:
+		return TokenRecordDeserialize(data)
+	case KeyCollectionAuthorityRecord:
+		return CollectionAuthorityRecordDeserialize(data)
+	case KeyUseAuthorityRecord:
+		return UseAuthorityRecordDeserialize(data)
+	case KeyMetadataDelegate:
+		return MetadataDelegateRecordDeserialize(data)
+	case KeyHolderDelegate:
+		return HolderDelegateRecordDeserialize(data)
+	}
+	return nil, fmt.Errorf("%w: %v", ErrInvalidAccountKey, data[0])
+}
+
+func deserializeAccount[T any](data []byte, key Key) (T, error) {
+	var account T
+	if len(data) == 0 {
+		return account, ErrInvalidAccountDataSize
+	}
+	if Key(data[0]) != key {
+		return account, ErrInvalidAccountKey
+	}
+	if err := borsh.Deserialize(data, &account); err != nil {
+		return account, fmt.Errorf("failed to deserialize data, err: %v", err)
+	}
+	return account, nil
+}
@@ -161,3 +161,132 @@ func TestMetadataDeserialize(t *testing.T) {
 		})
 	}
 }
+
+func TestDeserialize(t *testing.T) {
+	parent := common.PublicKeyFromString("9BKWqDHfHZh9j39xakYVMdr6hXmCLHH5VfCpeq2idU9L")
+	delegate := common.PublicKeyFromString("HNGVuL5kqjDehw7KR63w9gxow32sX6xzRNgLb8GkbwCM")
+
+	edition := []byte{byte(KeyEditionV1)}
+	edition = append(edition, parent.Bytes()...)
+	edition = append(edition, 7, 0, 0, 0, 0, 0, 0, 0)
+	edition = append(edition, make([]byte, 200)...) // padding
+
+	marker := append([]byte{byte(KeyEditionMarker), 0b1000_0001}, make([]byte, 30)...)
+	marker[31] = 0b0000_0010
+
+	tokenRecord := []byte{byte(KeyTokenRecord), 254, byte(TokenStateLocked), 0, 1}
+	tokenRecord = append(tokenRecord, delegate.Bytes()...)
+	tokenRecord = append(tokenRecord, 1, byte(TokenDelegateRoleUtility), 0)
+
+	tests := []struct {
+		name         string
+		data         []byte
+		accountOwner common.PublicKey
+		want         any
+		wantErr      error
+	}{
+		{
+			name:         "edition",
+			data:         edition,
+			accountOwner: common.MetaplexTokenMetaProgramID,
+			want:         Edition{Key: KeyEditionV1, Parent: parent, Edition: 7},
+		},
+		{
+			name:         "master edition",
+			data:         []byte{byte(KeyMasterEditionV2), 1, 0, 0, 0, 0, 0, 0, 0, 1, 10, 0, 0, 0, 0, 0, 0, 0},
+			accountOwner: common.MetaplexTokenMetaProgramID,
+			want:         MasterEditionV2{Key: KeyMasterEditionV2, Supply: 1, MaxSupply: pointer.Get[uint64](10)},
+		},
+		{
+			name:         "edition marker",
+			data:         marker,
+			accountOwner: common.MetaplexTokenMetaProgramID,
+			want: EditionMarker{
+				Key:    KeyEditionMarker,
+				Ledger: [31]uint8{0b1000_0001, 30: 0b0000_0010},
+			},
+		},
+		{
+			name:         "edition marker v2",
+			data:         []byte{byte(KeyEditionMarkerV2), 2, 0, 0, 0, 0b0100_0000, 0b0000_0001},
+			accountOwner: common.MetaplexTokenMetaProgramID,
+			want:         EditionMarkerV2{Key: KeyEditionMarkerV2, Ledger: []uint8{0b0100_0000, 0b0000_0001}},
+		},
+		{
+			name:         "token record",
+			data:         tokenRecord,
+			accountOwner: common.MetaplexTokenMetaProgramID,
+			want: TokenRecord{
+				Key:          KeyTokenRecord,
+				Bump:         254,
+				State:        TokenStateLocked,
+				Delegate:     &delegate,
+				DelegateRole: pointer.Get(TokenDelegateRoleUtility),
+			},
+		},
+		{
+			name:         "collection authority record",
+			data:         append([]byte{byte(KeyCollectionAuthorityRecord), 255, 1}, parent.Bytes()...),
+			accountOwner: common.MetaplexTokenMetaProgramID,
+			want:         CollectionAuthorityRecord{Key: KeyCollectionAuthorityRecord, Bump: 255, UpdateAuthority: &parent},
+		},
+		{
+			name:         "use authority record",
+			data:         []byte{byte(KeyUseAuthorityRecord), 3, 0, 0, 0, 0, 0, 0, 0, 253},
+			accountOwner: common.MetaplexTokenMetaProgramID,
+			want:         UseAuthorityRecord{Key: KeyUseAuthorityRecord, AllowedUses: 3, Bump: 253},
+		},
+		{
+			name:         "invalid owner",
+			data:         []byte{byte(KeyUseAuthorityRecord)},
+			accountOwner: common.SystemProgramID,
+			wantErr:      ErrInvalidAccountOwner,
+		},
+		{
+			name:         "empty data",
+			data:         []byte{},
+			accountOwner: common.MetaplexTokenMetaProgramID,
+			wantErr:      ErrInvalidAccountDataSize,
+		},
+		{
+			name:         "unknown key",
+			data:         []byte{byte(KeyReservationListV2)},
+			accountOwner: common.MetaplexTokenMetaProgramID,
+			wantErr:      ErrInvalidAccountKey,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Deserialize(tt.data, tt.accountOwner)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err := EditionDeserialize(tokenRecord)
+	assert.ErrorIs(t, err, ErrInvalidAccountKey)
+}
+
+func TestEditionTaken(t *testing.T) {
+	marker := EditionMarker{Ledger: [31]uint8{0b1000_0001, 30: 0b0000_0010}}
+	tests := []struct {
+		edition uint64
+		want    bool
+	}{
+		{edition: 0, want: true},
+		{edition: 1, want: false},
+		{edition: 7, want: true},
+		{edition: 246, want: true},
+		{edition: 247, want: false},
+		{edition: 248, want: true}, // first edition of the next marker
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, marker.EditionTaken(tt.edition), "edition %d", tt.edition)
+	}
+
+	markerV2 := EditionMarkerV2{Ledger: []uint8{0b0100_0000, 0b0000_0001}}
+	assert.True(t, markerV2.EditionTaken(1))
+	assert.True(t, markerV2.EditionTaken(15))
+	assert.False(t, markerV2.EditionTaken(0))
+	assert.False(t, markerV2.EditionTaken(16))
+}
@@ -1,6 +1,7 @@
 package token_metadata
 
 import (
+	"fmt"
 	"strconv"
 
 	"github.com/qimeila/solana-go-sdk/common"
@@ -51,3 +52,82 @@ func GetEditionMark(mint common.PublicKey, edition uint64) (common.PublicKey, er
 	)
 	return pubkey, err
 }
+
+// GetTokenRecord derives the token record of a programmable nft's token account
+func GetTokenRecord(mint, token common.PublicKey) (common.PublicKey, error) {
+	pubkey, _, err := common.FindProgramAddress(
+		[][]byte{
+			[]byte("metadata"),
+			common.MetaplexTokenMetaProgramID.Bytes(),
+			mint.Bytes(),
+			[]byte("token_record"),
+			token.Bytes(),
+		},
+		common.MetaplexTokenMetaProgramID,
+	)
+	return pubkey, err
+}
+
+// GetDelegateRecord derives the record of a metadata delegate or a print delegate.
+// authority is the update authority, or the token owner for a print delegate.
+// Token delegates have no record, their state lives in the token record.
+func GetDelegateRecord(mint common.PublicKey, role DelegateRole, authority, delegate common.PublicKey) (common.PublicKey, error) {
+	if role.IsTokenDelegate() {
+		return common.PublicKey{}, fmt.Errorf("token delegates have no delegate record")
+	}
+	pubkey, _, err := common.FindProgramAddress(
+		[][]byte{
+			[]byte("metadata"),
+			common.MetaplexTokenMetaProgramID.Bytes(),
+			mint.Bytes(),
+			[]byte(role.seed()),
+			authority.Bytes(),
+			delegate.Bytes(),
+		},
+		common.MetaplexTokenMetaProgramID,
+	)
+	return pubkey, err
+}
+
+// GetEditionMarkerV2 derives the single edition marker of a programmable master edition
+func GetEditionMarkerV2(mint common.PublicKey) (common.PublicKey, error) {
+	pubkey, _, err := common.FindProgramAddress(
+		[][]byte{
+			[]byte("metadata"),
+			common.MetaplexTokenMetaProgramID.Bytes(),
+			mint.Bytes(),
+			[]byte("edition"),
+			[]byte("marker"),
+		},
+		common.MetaplexTokenMetaProgramID,
+	)
+	return pubkey, err
+}
+
+func GetCollectionAuthorityRecord(mint, authority common.PublicKey) (common.PublicKey, error) {
+	pubkey, _, err := common.FindProgramAddress(
+		[][]byte{
+			[]byte("metadata"),
+			common.MetaplexTokenMetaProgramID.Bytes(),
+			mint.Bytes(),
+			[]byte("collection_authority"),
+			authority.Bytes(),
+		},
+		common.MetaplexTokenMetaProgramID,
+	)
+	return pubkey, err
+}
+
+func GetUseAuthorityRecord(mint, authority common.PublicKey) (common.PublicKey, error) {
+	pubkey, _, err := common.FindProgramAddress(
+		[][]byte{
+			[]byte("metadata"),
+			common.MetaplexTokenMetaProgramID.Bytes(),
+			mint.Bytes(),
+			[]byte("user"),
+			authority.Bytes(),
+		},
+		common.MetaplexTokenMetaProgramID,
+	)
+	return pubkey, err
+}
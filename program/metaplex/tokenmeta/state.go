@@ -4,8 +4,8 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/near/borsh-go"
 	"github.com/qimeila/solana-go-sdk/common"
+	"github.com/qimeila/solana-go-sdk/pkg/borsh"
 )
 
 const EDITION_MARKER_BIT_SIZE uint64 = 248
@@ -104,7 +104,7 @@ const (
 )
 
 type CollectionDetails struct {
-	Enum borsh.Enum `borsh_enum:"true"`
+	Enum borsh.Enum `borsh:"enum"`
 	V1   CollectionDetailsV1
 }
 
@@ -113,7 +113,7 @@ type CollectionDetailsV1 struct {
 }
 
 type ProgrammableConfig struct {
-	Enum borsh.Enum `borsh_enum:"true"`
+	Enum borsh.Enum `borsh:"enum"`
 	V1   ProgrammableConfigV1
 }
 
@@ -123,13 +123,13 @@ type ProgrammableConfigV1 struct {
 
 func MetadataDeserialize(data []byte) (Metadata, error) {
 	var metadata Metadata
-	err := borsh.Deserialize(&metadata, data)
+	err := borsh.Deserialize(data, &metadata)
 	if err != nil {
 		// https://github.com/samuelvanderwaal/metaboss/issues/121
 		// https://github.com/metaplex-foundation/metaplex-program-library/pull/407
 		// C.f. https://github.com/metaplex-foundation/metaplex-program-library/blob/master/token-metadata/program/src/deser.rs#L12
 		var metadataPreV11 metadataPreV11
-		err := borsh.Deserialize(&metadataPreV11, data)
+		err := borsh.Deserialize(data, &metadataPreV11)
 		if err != nil {
 			return Metadata{}, fmt.Errorf("failed to deserialize data, err: %v", err)
 		} else {
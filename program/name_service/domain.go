@@ -0,0 +1,83 @@
+package name_service
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"github.com/qimeila/solana-go-sdk/common"
+)
+
+// ReverseLookupClass is the class of the reverse name records of .sol domains
+var ReverseLookupClass = common.PublicKeyFromString("33m47vH6Eav6jr5Ry86XjhRft2jRBLDnDgPSHoquXi2Z")
+
+// NameOffersProgramID keeps the favourite domain of a wallet
+var NameOffersProgramID = common.PublicKeyFromString("85iDfUvr3HJyLM2zcq5BXSiDvUWfw6cSE1FfNBo8Ap29")
+
+const SolTld = ".sol"
+
+// GetDomainKey returns the name account of a .sol domain or a subdomain of one, e.g. "alice.sol" or "pay.alice.sol"
+func GetDomainKey(domain string) (common.PublicKey, error) {
+	labels := strings.Split(strings.TrimSuffix(domain, SolTld), ".")
+	for _, label := range labels {
+		if label == "" {
+			return common.PublicKey{}, fmt.Errorf("%w: %v", ErrInvalidDomain, domain)
+		}
+	}
+
+	switch len(labels) {
+	case 1:
+		return GetNameAccountKey(GetHashName(labels[0]), common.PublicKey{}, SolTldAuthority), nil
+	case 2:
+		parent := GetNameAccountKey(GetHashName(labels[1]), common.PublicKey{}, SolTldAuthority)
+		return GetNameAccountKey(GetHashName("\x00"+labels[0]), common.PublicKey{}, parent), nil
+	}
+	return common.PublicKey{}, fmt.Errorf("%w: %v", ErrInvalidDomain, domain)
+}
+
+// GetReverseKey returns the reverse name record of a name account, parent is the parent domain of a subdomain and empty otherwise
+func GetReverseKey(nameAccount, parent common.PublicKey) common.PublicKey {
+	return GetNameAccountKey(GetHashName(nameAccount.ToBase58()), ReverseLookupClass, parent)
+}
+
+// DeserializeReverseName decodes the payload of a reverse name record, the leading zero of a subdomain is removed
+func DeserializeReverseName(data []byte) (string, error) {
+	if len(data) < 4 {
+		return "", ErrInvalidReverseName
+	}
+	l := binary.LittleEndian.Uint32(data[:4])
+	if uint64(len(data)-4) < uint64(l) {
+		return "", ErrInvalidReverseName
+	}
+	return strings.ReplaceAll(string(data[4:4+l]), "\x00", ""), nil
+}
+
+// GetFavouriteDomainKey returns the account keeping the favourite domain of a wallet
+func GetFavouriteDomainKey(owner common.PublicKey) common.PublicKey {
+	pubkey, _, _ := common.FindProgramAddress(
+		[][]byte{
+			[]byte("favourite_domain"),
+			owner.Bytes(),
+		},
+		NameOffersProgramID,
+	)
+	return pubkey
+}
+
+type FavouriteDomain struct {
+	Tag         uint8
+	NameAccount common.PublicKey
+}
+
+func DeserializeFavouriteDomain(data []byte, accountOwner common.PublicKey) (FavouriteDomain, error) {
+	if accountOwner != NameOffersProgramID {
+		return FavouriteDomain{}, ErrInvalidAccountOwner
+	}
+	if len(data) < 33 {
+		return FavouriteDomain{}, fmt.Errorf("data length should be at least 33")
+	}
+	return FavouriteDomain{
+		Tag:         data[0],
+		NameAccount: common.PublicKeyFromBytes(data[1:33]),
+	}, nil
+}
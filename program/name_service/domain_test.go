@@ -0,0 +1,131 @@
+package name_service
+
+import (
+	"testing"
+
+	"github.com/qimeila/solana-go-sdk/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetDomainKey(t *testing.T) {
+	type args struct {
+		domain string
+	}
+	tests := []struct {
+		name string
+		args args
+		want common.PublicKey
+		err  error
+	}{
+		{
+			args: args{
+				domain: "bonfida.sol",
+			},
+			want: common.PublicKeyFromString("Crf8hzfthWGbGbLTVCiqRqV5MVnbpHB1L9KQMd6gsinb"),
+			err:  nil,
+		},
+		{
+			args: args{
+				domain: "bonfida",
+			},
+			want: common.PublicKeyFromString("Crf8hzfthWGbGbLTVCiqRqV5MVnbpHB1L9KQMd6gsinb"),
+			err:  nil,
+		},
+		{
+			args: args{
+				domain: "dex.bonfida.sol",
+			},
+			want: GetNameAccountKey(GetHashName("\x00dex"), common.PublicKey{}, common.PublicKeyFromString("Crf8hzfthWGbGbLTVCiqRqV5MVnbpHB1L9KQMd6gsinb")),
+			err:  nil,
+		},
+		{
+			args: args{
+				domain: ".sol",
+			},
+			want: common.PublicKey{},
+			err:  ErrInvalidDomain,
+		},
+		{
+			args: args{
+				domain: "a.b.c.sol",
+			},
+			want: common.PublicKey{},
+			err:  ErrInvalidDomain,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GetDomainKey(tt.args.domain)
+			assert.ErrorIs(t, err, tt.err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGetReverseKey(t *testing.T) {
+	domain := common.PublicKeyFromString("Crf8hzfthWGbGbLTVCiqRqV5MVnbpHB1L9KQMd6gsinb")
+	assert.Equal(t,
+		GetNameAccountKey(GetHashName(domain.ToBase58()), ReverseLookupClass, common.PublicKey{}),
+		GetReverseKey(domain, common.PublicKey{}),
+	)
+	assert.NotEqual(t, GetReverseKey(domain, common.PublicKey{}), GetReverseKey(domain, SolTldAuthority))
+}
+
+func TestDeserializeReverseName(t *testing.T) {
+	type args struct {
+		data []byte
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+		err  error
+	}{
+		{
+			args: args{
+				data: []byte{7, 0, 0, 0, 'b', 'o', 'n', 'f', 'i', 'd', 'a'},
+			},
+			want: "bonfida",
+			err:  nil,
+		},
+		{
+			args: args{
+				data: []byte{4, 0, 0, 0, 0, 'd', 'e', 'x', 0, 0},
+			},
+			want: "dex",
+			err:  nil,
+		},
+		{
+			args: args{
+				data: []byte{8, 0, 0, 0, 'b', 'o', 'n', 'f', 'i', 'd', 'a'},
+			},
+			want: "",
+			err:  ErrInvalidReverseName,
+		},
+		{
+			args: args{
+				data: []byte{1, 0},
+			},
+			want: "",
+			err:  ErrInvalidReverseName,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DeserializeReverseName(tt.args.data)
+			assert.ErrorIs(t, err, tt.err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDeserializeFavouriteDomain(t *testing.T) {
+	domain := common.PublicKeyFromString("Crf8hzfthWGbGbLTVCiqRqV5MVnbpHB1L9KQMd6gsinb")
+
+	got, err := DeserializeFavouriteDomain(append([]byte{1}, domain.Bytes()...), NameOffersProgramID)
+	assert.Nil(t, err)
+	assert.Equal(t, FavouriteDomain{Tag: 1, NameAccount: domain}, got)
+
+	_, err = DeserializeFavouriteDomain(append([]byte{1}, domain.Bytes()...), common.SystemProgramID)
+	assert.ErrorIs(t, err, ErrInvalidAccountOwner)
+}
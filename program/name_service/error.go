@@ -0,0 +1,11 @@
+package name_service
+
+import "errors"
+
+var (
+	ErrInvalidAccountOwner = errors.New("invalid account owner")
+	ErrInvalidDomain       = errors.New("invalid domain")
+	ErrInvalidReverseName  = errors.New("invalid reverse name")
+	ErrInvalidRecord       = errors.New("invalid record")
+	ErrDomainNotFound      = errors.New("domain not found")
+)
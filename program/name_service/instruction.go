@@ -0,0 +1,205 @@
+package name_service
+
+import (
+	"github.com/qimeila/solana-go-sdk/common"
+	"github.com/qimeila/solana-go-sdk/pkg/borsh"
+	"github.com/qimeila/solana-go-sdk/types"
+)
+
+type Instruction uint8
+
+const (
+	InstructionCreate Instruction = iota
+	InstructionUpdate
+	InstructionTransfer
+	InstructionDelete
+	InstructionRealloc
+)
+
+type CreateParam struct {
+	Payer       common.PublicKey
+	NameAccount common.PublicKey
+	NameOwner   common.PublicKey
+	// NameClass must sign when it is set
+	NameClass  *common.PublicKey
+	ParentName *common.PublicKey
+	// ParentNameOwner must sign to create a name under ParentName
+	ParentNameOwner *common.PublicKey
+	HashedName      []byte
+	Lamports        uint64
+	// Space is the size of the payload, the header is added by the program
+	Space uint32
+}
+
+// Create creates a name record, NameAccount is derived by GetNameAccountKey from the same hashed name, class and parent
+func Create(param CreateParam) types.Instruction {
+	data, err := borsh.Serialize(struct {
+		Instruction Instruction
+		HashedName  []byte
+		Lamports    uint64
+		Space       uint32
+	}{
+		Instruction: InstructionCreate,
+		HashedName:  param.HashedName,
+		Lamports:    param.Lamports,
+		Space:       param.Space,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	accounts := []types.AccountMeta{
+		{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+		{PubKey: param.Payer, IsSigner: true, IsWritable: true},
+		{PubKey: param.NameAccount, IsSigner: false, IsWritable: true},
+		{PubKey: param.NameOwner, IsSigner: false, IsWritable: false},
+	}
+	if param.NameClass != nil {
+		accounts = append(accounts, types.AccountMeta{PubKey: *param.NameClass, IsSigner: true, IsWritable: false})
+	} else {
+		accounts = append(accounts, types.AccountMeta{PubKey: common.PublicKey{}, IsSigner: false, IsWritable: false})
+	}
+	if param.ParentName != nil {
+		accounts = append(accounts, types.AccountMeta{PubKey: *param.ParentName, IsSigner: false, IsWritable: false})
+	} else {
+		accounts = append(accounts, types.AccountMeta{PubKey: common.PublicKey{}, IsSigner: false, IsWritable: false})
+	}
+	if param.ParentNameOwner != nil {
+		accounts = append(accounts, types.AccountMeta{PubKey: *param.ParentNameOwner, IsSigner: true, IsWritable: false})
+	}
+
+	return types.Instruction{
+		ProgramID: common.SPLNameServiceProgramID,
+		Accounts:  accounts,
+		Data:      data,
+	}
+}
+
+type UpdateParam struct {
+	NameAccount common.PublicKey
+	// UpdateSigner is the name owner, or the class when the name has one
+	UpdateSigner common.PublicKey
+	// Offset is counted from the start of the payload
+	Offset uint32
+	Data   []byte
+}
+
+// Update writes Data into the payload of a name record
+func Update(param UpdateParam) types.Instruction {
+	data, err := borsh.Serialize(struct {
+		Instruction Instruction
+		Offset      uint32
+		Data        []byte
+	}{
+		Instruction: InstructionUpdate,
+		Offset:      param.Offset,
+		Data:        param.Data,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: common.SPLNameServiceProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: param.NameAccount, IsSigner: false, IsWritable: true},
+			{PubKey: param.UpdateSigner, IsSigner: true, IsWritable: false},
+		},
+		Data: data,
+	}
+}
+
+type TransferParam struct {
+	NameAccount common.PublicKey
+	NameOwner   common.PublicKey
+	NewOwner    common.PublicKey
+	// NameClass must sign when the name has one
+	NameClass *common.PublicKey
+}
+
+func Transfer(param TransferParam) types.Instruction {
+	data, err := borsh.Serialize(struct {
+		Instruction Instruction
+		NewOwner    common.PublicKey
+	}{
+		Instruction: InstructionTransfer,
+		NewOwner:    param.NewOwner,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	accounts := []types.AccountMeta{
+		{PubKey: param.NameAccount, IsSigner: false, IsWritable: true},
+		{PubKey: param.NameOwner, IsSigner: true, IsWritable: false},
+	}
+	if param.NameClass != nil {
+		accounts = append(accounts, types.AccountMeta{PubKey: *param.NameClass, IsSigner: true, IsWritable: false})
+	}
+
+	return types.Instruction{
+		ProgramID: common.SPLNameServiceProgramID,
+		Accounts:  accounts,
+		Data:      data,
+	}
+}
+
+type DeleteParam struct {
+	NameAccount common.PublicKey
+	NameOwner   common.PublicKey
+	// RefundTarget receives the lamports of the name record
+	RefundTarget common.PublicKey
+}
+
+func Delete(param DeleteParam) types.Instruction {
+	data, err := borsh.Serialize(struct {
+		Instruction Instruction
+	}{
+		Instruction: InstructionDelete,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: common.SPLNameServiceProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: param.NameAccount, IsSigner: false, IsWritable: true},
+			{PubKey: param.NameOwner, IsSigner: true, IsWritable: false},
+			{PubKey: param.RefundTarget, IsSigner: false, IsWritable: true},
+		},
+		Data: data,
+	}
+}
+
+type ReallocParam struct {
+	Payer       common.PublicKey
+	NameAccount common.PublicKey
+	NameOwner   common.PublicKey
+	// Space is the new size of the payload, the payer funds or receives the rent difference
+	Space uint32
+}
+
+func Realloc(param ReallocParam) types.Instruction {
+	data, err := borsh.Serialize(struct {
+		Instruction Instruction
+		Space       uint32
+	}{
+		Instruction: InstructionRealloc,
+		Space:       param.Space,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: common.SPLNameServiceProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+			{PubKey: param.Payer, IsSigner: true, IsWritable: true},
+			{PubKey: param.NameAccount, IsSigner: false, IsWritable: true},
+			{PubKey: param.NameOwner, IsSigner: true, IsWritable: false},
+		},
+		Data: data,
+	}
+}
@@ -0,0 +1,239 @@
+package name_service
+
+import (
+	"testing"
+
+	"github.com/qimeila/solana-go-sdk/common"
+	"github.com/qimeila/solana-go-sdk/pkg/pointer"
+	"github.com/qimeila/solana-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreate(t *testing.T) {
+	type args struct {
+		param CreateParam
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Instruction
+	}{
+		{
+			args: args{
+				param: CreateParam{
+					Payer:       common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"),
+					NameAccount: common.PublicKeyFromString("CsAJLjVMY5v3FoUmbf8DSWUGrrNsHfu9dtYCt2t46ZiP"),
+					NameOwner:   common.PublicKeyFromString("6xTZhtNA8aaipc2hHFP616gFvDcvWmYMGsDFHwrsF3m1"),
+					HashedName:  []byte{1, 2, 3},
+					Lamports:    1000000,
+					Space:       8,
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.SPLNameServiceProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+					{PubKey: common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"), IsSigner: true, IsWritable: true},
+					{PubKey: common.PublicKeyFromString("CsAJLjVMY5v3FoUmbf8DSWUGrrNsHfu9dtYCt2t46ZiP"), IsSigner: false, IsWritable: true},
+					{PubKey: common.PublicKeyFromString("6xTZhtNA8aaipc2hHFP616gFvDcvWmYMGsDFHwrsF3m1"), IsSigner: false, IsWritable: false},
+					{PubKey: common.PublicKey{}, IsSigner: false, IsWritable: false},
+					{PubKey: common.PublicKey{}, IsSigner: false, IsWritable: false},
+				},
+				Data: []byte{0, 3, 0, 0, 0, 1, 2, 3, 64, 66, 15, 0, 0, 0, 0, 0, 8, 0, 0, 0},
+			},
+		},
+		{
+			args: args{
+				param: CreateParam{
+					Payer:           common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"),
+					NameAccount:     common.PublicKeyFromString("CsAJLjVMY5v3FoUmbf8DSWUGrrNsHfu9dtYCt2t46ZiP"),
+					NameOwner:       common.PublicKeyFromString("6xTZhtNA8aaipc2hHFP616gFvDcvWmYMGsDFHwrsF3m1"),
+					NameClass:       pointer.Get(common.PublicKeyFromString("EqiAwWAWn4QigUfpzKHFXTcG3RpBCGbRr8T4WAkYa7Zh")),
+					ParentName:      pointer.Get(SolTldAuthority),
+					ParentNameOwner: pointer.Get(common.PublicKeyFromString("58PwtjSDuFHuUkYjH9BYnnQKHfwo9reZhC2zMJv9JPkx")),
+					HashedName:      []byte{1},
+					Lamports:        1,
+					Space:           0,
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.SPLNameServiceProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+					{PubKey: common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"), IsSigner: true, IsWritable: true},
+					{PubKey: common.PublicKeyFromString("CsAJLjVMY5v3FoUmbf8DSWUGrrNsHfu9dtYCt2t46ZiP"), IsSigner: false, IsWritable: true},
+					{PubKey: common.PublicKeyFromString("6xTZhtNA8aaipc2hHFP616gFvDcvWmYMGsDFHwrsF3m1"), IsSigner: false, IsWritable: false},
+					{PubKey: common.PublicKeyFromString("EqiAwWAWn4QigUfpzKHFXTcG3RpBCGbRr8T4WAkYa7Zh"), IsSigner: true, IsWritable: false},
+					{PubKey: SolTldAuthority, IsSigner: false, IsWritable: false},
+					{PubKey: common.PublicKeyFromString("58PwtjSDuFHuUkYjH9BYnnQKHfwo9reZhC2zMJv9JPkx"), IsSigner: true, IsWritable: false},
+				},
+				Data: []byte{0, 1, 0, 0, 0, 1, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Create(tt.args.param))
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type args struct {
+		param UpdateParam
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Instruction
+	}{
+		{
+			args: args{
+				param: UpdateParam{
+					NameAccount:  common.PublicKeyFromString("CsAJLjVMY5v3FoUmbf8DSWUGrrNsHfu9dtYCt2t46ZiP"),
+					UpdateSigner: common.PublicKeyFromString("6xTZhtNA8aaipc2hHFP616gFvDcvWmYMGsDFHwrsF3m1"),
+					Offset:       2,
+					Data:         []byte("hi"),
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.SPLNameServiceProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("CsAJLjVMY5v3FoUmbf8DSWUGrrNsHfu9dtYCt2t46ZiP"), IsSigner: false, IsWritable: true},
+					{PubKey: common.PublicKeyFromString("6xTZhtNA8aaipc2hHFP616gFvDcvWmYMGsDFHwrsF3m1"), IsSigner: true, IsWritable: false},
+				},
+				Data: []byte{1, 2, 0, 0, 0, 2, 0, 0, 0, 'h', 'i'},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Update(tt.args.param))
+		})
+	}
+}
+
+func TestTransfer(t *testing.T) {
+	type args struct {
+		param TransferParam
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Instruction
+	}{
+		{
+			args: args{
+				param: TransferParam{
+					NameAccount: common.PublicKeyFromString("CsAJLjVMY5v3FoUmbf8DSWUGrrNsHfu9dtYCt2t46ZiP"),
+					NameOwner:   common.PublicKeyFromString("6xTZhtNA8aaipc2hHFP616gFvDcvWmYMGsDFHwrsF3m1"),
+					NewOwner:    common.SystemProgramID,
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.SPLNameServiceProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("CsAJLjVMY5v3FoUmbf8DSWUGrrNsHfu9dtYCt2t46ZiP"), IsSigner: false, IsWritable: true},
+					{PubKey: common.PublicKeyFromString("6xTZhtNA8aaipc2hHFP616gFvDcvWmYMGsDFHwrsF3m1"), IsSigner: true, IsWritable: false},
+				},
+				Data: append([]byte{2}, make([]byte, 32)...),
+			},
+		},
+		{
+			args: args{
+				param: TransferParam{
+					NameAccount: common.PublicKeyFromString("CsAJLjVMY5v3FoUmbf8DSWUGrrNsHfu9dtYCt2t46ZiP"),
+					NameOwner:   common.PublicKeyFromString("6xTZhtNA8aaipc2hHFP616gFvDcvWmYMGsDFHwrsF3m1"),
+					NewOwner:    common.PublicKeyFromString("EqiAwWAWn4QigUfpzKHFXTcG3RpBCGbRr8T4WAkYa7Zh"),
+					NameClass:   pointer.Get(common.PublicKeyFromString("58PwtjSDuFHuUkYjH9BYnnQKHfwo9reZhC2zMJv9JPkx")),
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.SPLNameServiceProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("CsAJLjVMY5v3FoUmbf8DSWUGrrNsHfu9dtYCt2t46ZiP"), IsSigner: false, IsWritable: true},
+					{PubKey: common.PublicKeyFromString("6xTZhtNA8aaipc2hHFP616gFvDcvWmYMGsDFHwrsF3m1"), IsSigner: true, IsWritable: false},
+					{PubKey: common.PublicKeyFromString("58PwtjSDuFHuUkYjH9BYnnQKHfwo9reZhC2zMJv9JPkx"), IsSigner: true, IsWritable: false},
+				},
+				Data: append([]byte{2}, common.PublicKeyFromString("EqiAwWAWn4QigUfpzKHFXTcG3RpBCGbRr8T4WAkYa7Zh").Bytes()...),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Transfer(tt.args.param))
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type args struct {
+		param DeleteParam
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Instruction
+	}{
+		{
+			args: args{
+				param: DeleteParam{
+					NameAccount:  common.PublicKeyFromString("CsAJLjVMY5v3FoUmbf8DSWUGrrNsHfu9dtYCt2t46ZiP"),
+					NameOwner:    common.PublicKeyFromString("6xTZhtNA8aaipc2hHFP616gFvDcvWmYMGsDFHwrsF3m1"),
+					RefundTarget: common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"),
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.SPLNameServiceProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("CsAJLjVMY5v3FoUmbf8DSWUGrrNsHfu9dtYCt2t46ZiP"), IsSigner: false, IsWritable: true},
+					{PubKey: common.PublicKeyFromString("6xTZhtNA8aaipc2hHFP616gFvDcvWmYMGsDFHwrsF3m1"), IsSigner: true, IsWritable: false},
+					{PubKey: common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"), IsSigner: false, IsWritable: true},
+				},
+				Data: []byte{3},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Delete(tt.args.param))
+		})
+	}
+}
+
+func TestRealloc(t *testing.T) {
+	type args struct {
+		param ReallocParam
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Instruction
+	}{
+		{
+			args: args{
+				param: ReallocParam{
+					Payer:       common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"),
+					NameAccount: common.PublicKeyFromString("CsAJLjVMY5v3FoUmbf8DSWUGrrNsHfu9dtYCt2t46ZiP"),
+					NameOwner:   common.PublicKeyFromString("6xTZhtNA8aaipc2hHFP616gFvDcvWmYMGsDFHwrsF3m1"),
+					Space:       1000,
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.SPLNameServiceProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+					{PubKey: common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"), IsSigner: true, IsWritable: true},
+					{PubKey: common.PublicKeyFromString("CsAJLjVMY5v3FoUmbf8DSWUGrrNsHfu9dtYCt2t46ZiP"), IsSigner: false, IsWritable: true},
+					{PubKey: common.PublicKeyFromString("6xTZhtNA8aaipc2hHFP616gFvDcvWmYMGsDFHwrsF3m1"), IsSigner: true, IsWritable: false},
+				},
+				Data: []byte{4, 232, 3, 0, 0},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Realloc(tt.args.param))
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package name_service
+
+import (
+	"crypto/ed25519"
+	"encoding/binary"
+	"encoding/hex"
+
+	"github.com/qimeila/solana-go-sdk/common"
+)
+
+// SNSRecordsProgramID manages the v2 records of a domain
+var SNSRecordsProgramID = common.PublicKeyFromString("HP3D4D1ZCmohQGFVms2SS4LCANgJyksBf5s1F77FuFjZ")
+
+// SNSRecordsCentralState is the class of v2 records, a pda of SNSRecordsProgramID seeded with its own id
+var SNSRecordsCentralState = common.PublicKeyFromString("2pMnqHvei2N5oDcVGCRdZx48gqti199wr5CsyTTafsbo")
+
+type Record string
+
+const (
+	RecordSOL Record = "SOL"
+)
+
+// GetRecordKey returns the v1 record of a domain
+func GetRecordKey(domain common.PublicKey, record Record) common.PublicKey {
+	return GetNameAccountKey(GetHashName("\x01"+string(record)), common.PublicKey{}, domain)
+}
+
+// GetRecordV2Key returns the v2 record of a domain
+func GetRecordV2Key(domain common.PublicKey, record Record) common.PublicKey {
+	return GetNameAccountKey(GetHashName("\x02"+string(record)), SNSRecordsCentralState, domain)
+}
+
+// SolRecordV1Destination returns the wallet of a v1 SOL record, it is a destination followed by
+// the domain owner's signature of hex(destination || record key)
+func SolRecordV1Destination(data []byte, recordKey, domainOwner common.PublicKey) (common.PublicKey, bool) {
+	if len(data) < 96 {
+		return common.PublicKey{}, false
+	}
+	msg := []byte(hex.EncodeToString(append(append([]byte{}, data[:32]...), recordKey.Bytes()...)))
+	if !ed25519.Verify(ed25519.PublicKey(domainOwner.Bytes()), msg, data[32:96]) {
+		return common.PublicKey{}, false
+	}
+	return common.PublicKeyFromBytes(data[:32]), true
+}
+
+type Validation uint16
+
+const (
+	ValidationNone Validation = iota
+	ValidationSolana
+	ValidationEthereum
+	ValidationUnverifiedSolana
+)
+
+func (v Validation) size() (int, bool) {
+	switch v {
+	case ValidationNone:
+		return 0, true
+	case ValidationSolana, ValidationUnverifiedSolana:
+		return 32, true
+	case ValidationEthereum:
+		return 20, true
+	}
+	return 0, false
+}
+
+// RecordV2 is the payload of a v2 record
+type RecordV2 struct {
+	StalenessValidation          Validation
+	RightOfAssociationValidation Validation
+	StalenessId                  []byte
+	RightOfAssociationId         []byte
+	Content                      []byte
+}
+
+func DeserializeRecordV2(data []byte) (RecordV2, error) {
+	if len(data) < 8 {
+		return RecordV2{}, ErrInvalidRecord
+	}
+	record := RecordV2{
+		StalenessValidation:          Validation(binary.LittleEndian.Uint16(data[0:2])),
+		RightOfAssociationValidation: Validation(binary.LittleEndian.Uint16(data[2:4])),
+	}
+	contentLength := binary.LittleEndian.Uint32(data[4:8])
+
+	stalenessSize, ok := record.StalenessValidation.size()
+	if !ok {
+		return RecordV2{}, ErrInvalidRecord
+	}
+	roaSize, ok := record.RightOfAssociationValidation.size()
+	if !ok {
+		return RecordV2{}, ErrInvalidRecord
+	}
+	if uint64(len(data)-8) < uint64(stalenessSize)+uint64(roaSize)+uint64(contentLength) {
+		return RecordV2{}, ErrInvalidRecord
+	}
+
+	current := 8
+	record.StalenessId = data[current : current+stalenessSize]
+	current += stalenessSize
+	record.RightOfAssociationId = data[current : current+roaSize]
+	current += roaSize
+	record.Content = data[current : current+int(contentLength)]
+	return record, nil
+}
+
+// SolDestination returns the wallet of a v2 SOL record. The record is only trusted when
+// the current domain owner signed it and the wallet proved it owns the content.
+func (r RecordV2) SolDestination(domainOwner common.PublicKey) (common.PublicKey, bool) {
+	if len(r.Content) != 32 {
+		return common.PublicKey{}, false
+	}
+	if r.StalenessValidation != ValidationSolana || common.PublicKeyFromBytes(r.StalenessId) != domainOwner {
+		return common.PublicKey{}, false
+	}
+	if r.RightOfAssociationValidation != ValidationSolana || string(r.RightOfAssociationId) != string(r.Content) {
+		return common.PublicKey{}, false
+	}
+	return common.PublicKeyFromBytes(r.Content), true
+}
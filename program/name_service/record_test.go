@@ -0,0 +1,123 @@
+package name_service
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"testing"
+
+	"github.com/qimeila/solana-go-sdk/common"
+	"github.com/qimeila/solana-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSolRecordV1Destination(t *testing.T) {
+	owner, err := types.AccountFromSeed(make([]byte, 32))
+	assert.Nil(t, err)
+	destination := common.PublicKeyFromString("6xTZhtNA8aaipc2hHFP616gFvDcvWmYMGsDFHwrsF3m1")
+	recordKey := GetRecordKey(common.PublicKeyFromString("Crf8hzfthWGbGbLTVCiqRqV5MVnbpHB1L9KQMd6gsinb"), RecordSOL)
+
+	msg := []byte(hex.EncodeToString(append(destination.Bytes(), recordKey.Bytes()...)))
+	data := append(destination.Bytes(), ed25519.Sign(owner.PrivateKey, msg)...)
+
+	got, ok := SolRecordV1Destination(data, recordKey, owner.PublicKey)
+	assert.True(t, ok)
+	assert.Equal(t, destination, got)
+
+	_, ok = SolRecordV1Destination(data, recordKey, destination)
+	assert.False(t, ok)
+
+	_, ok = SolRecordV1Destination(data, GetRecordV2Key(common.PublicKeyFromString("Crf8hzfthWGbGbLTVCiqRqV5MVnbpHB1L9KQMd6gsinb"), RecordSOL), owner.PublicKey)
+	assert.False(t, ok)
+
+	_, ok = SolRecordV1Destination(data[:64], recordKey, owner.PublicKey)
+	assert.False(t, ok)
+}
+
+func TestDeserializeRecordV2(t *testing.T) {
+	owner := common.PublicKeyFromString("58PwtjSDuFHuUkYjH9BYnnQKHfwo9reZhC2zMJv9JPkx")
+	destination := common.PublicKeyFromString("6xTZhtNA8aaipc2hHFP616gFvDcvWmYMGsDFHwrsF3m1")
+
+	type args struct {
+		data []byte
+	}
+	tests := []struct {
+		name string
+		args args
+		want RecordV2
+		err  error
+	}{
+		{
+			args: args{
+				data: append(append(append([]byte{1, 0, 1, 0, 32, 0, 0, 0}, owner.Bytes()...), destination.Bytes()...), destination.Bytes()...),
+			},
+			want: RecordV2{
+				StalenessValidation:          ValidationSolana,
+				RightOfAssociationValidation: ValidationSolana,
+				StalenessId:                  owner.Bytes(),
+				RightOfAssociationId:         destination.Bytes(),
+				Content:                      destination.Bytes(),
+			},
+			err: nil,
+		},
+		{
+			args: args{
+				data: []byte{0, 0, 2, 0, 2, 0, 0, 0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 'h', 'i'},
+			},
+			want: RecordV2{
+				StalenessValidation:          ValidationNone,
+				RightOfAssociationValidation: ValidationEthereum,
+				StalenessId:                  []byte{},
+				RightOfAssociationId:         []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20},
+				Content:                      []byte("hi"),
+			},
+			err: nil,
+		},
+		{
+			args: args{
+				data: []byte{0, 0, 0, 0, 3, 0, 0, 0, 'h', 'i'},
+			},
+			want: RecordV2{},
+			err:  ErrInvalidRecord,
+		},
+		{
+			args: args{
+				data: []byte{9, 0, 0, 0, 0, 0, 0, 0},
+			},
+			want: RecordV2{},
+			err:  ErrInvalidRecord,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DeserializeRecordV2(tt.args.data)
+			assert.ErrorIs(t, err, tt.err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRecordV2_SolDestination(t *testing.T) {
+	owner := common.PublicKeyFromString("58PwtjSDuFHuUkYjH9BYnnQKHfwo9reZhC2zMJv9JPkx")
+	destination := common.PublicKeyFromString("6xTZhtNA8aaipc2hHFP616gFvDcvWmYMGsDFHwrsF3m1")
+
+	record := RecordV2{
+		StalenessValidation:          ValidationSolana,
+		RightOfAssociationValidation: ValidationSolana,
+		StalenessId:                  owner.Bytes(),
+		RightOfAssociationId:         destination.Bytes(),
+		Content:                      destination.Bytes(),
+	}
+	got, ok := record.SolDestination(owner)
+	assert.True(t, ok)
+	assert.Equal(t, destination, got)
+
+	// stale after the domain is transferred
+	_, ok = record.SolDestination(destination)
+	assert.False(t, ok)
+
+	unverified := record
+	unverified.RightOfAssociationValidation = ValidationNone
+	unverified.RightOfAssociationId = []byte{}
+	_, ok = unverified.SolDestination(owner)
+	assert.False(t, ok)
+}
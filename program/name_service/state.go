@@ -6,6 +6,9 @@ import (
 	"github.com/qimeila/solana-go-sdk/common"
 )
 
+// HeaderSize is the size of a name record's header, the payload follows it
+const HeaderSize = 96
+
 type NameRecordHeader struct {
 	ParentName common.PublicKey
 	Owner      common.PublicKey
@@ -14,13 +17,20 @@ type NameRecordHeader struct {
 }
 
 func NameRecordHeaderFromData(data []byte) (NameRecordHeader, error) {
-	if len(data) < 96 {
+	if len(data) < HeaderSize {
 		return NameRecordHeader{}, fmt.Errorf("data length should bigger than 96")
 	}
 	return NameRecordHeader{
 		ParentName: common.PublicKeyFromBytes(data[:32]),
 		Owner:      common.PublicKeyFromBytes(data[32:64]),
-		Class:      common.PublicKeyFromBytes(data[64:96]),
-		Data:       data[96:],
+		Class:      common.PublicKeyFromBytes(data[64:HeaderSize]),
+		Data:       data[HeaderSize:],
 	}, nil
 }
+
+func DeserializeNameRecord(data []byte, accountOwner common.PublicKey) (NameRecordHeader, error) {
+	if accountOwner != common.SPLNameServiceProgramID {
+		return NameRecordHeader{}, ErrInvalidAccountOwner
+	}
+	return NameRecordHeaderFromData(data)
+}
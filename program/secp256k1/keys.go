@@ -0,0 +1,121 @@
+package secp256k1
+
+import (
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"golang.org/x/crypto/sha3"
+)
+
+const (
+	PrivateKeySize = 32
+	// PublicKeySize is an uncompressed public key without the 0x04 prefix, x || y
+	PublicKeySize  = 64
+	EthAddressSize = 20
+	// SignatureSize is r || s followed by the recovery id, as the precompile reads it
+	SignatureSize = 65
+
+	compactRecoveryCodeOffset = 27
+)
+
+func Keccak256(data ...[]byte) []byte {
+	h := sha3.NewLegacyKeccak256()
+	for _, b := range data {
+		h.Write(b)
+	}
+	return h.Sum(nil)
+}
+
+// PublicKeyFromPrivateKey returns the 64 bytes x || y public key of privateKey
+func PublicKeyFromPrivateKey(privateKey []byte) ([]byte, error) {
+	key, err := parsePrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	return encodePublicKey(key.PubKey()), nil
+}
+
+// EthAddressFromPublicKey derives the 20 bytes ethereum address, the last 20 bytes of keccak256(x || y).
+// publicKey can be 64 bytes x || y, 65 bytes with the 0x04 prefix or 33 bytes compressed.
+func EthAddressFromPublicKey(publicKey []byte) ([]byte, error) {
+	p, err := parsePublicKey(publicKey)
+	if err != nil {
+		return nil, err
+	}
+	return Keccak256(encodePublicKey(p))[32-EthAddressSize:], nil
+}
+
+// Sign signs keccak256(msg), which is what the precompile checks, and returns r || s || recovery id.
+// The nonce follows RFC 6979 so the signature is deterministic, s is always the low one.
+func Sign(privateKey []byte, msg []byte) ([]byte, error) {
+	key, err := parsePrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// compact is recovery code || r || s, the recovery code is 27 + recovery id for an uncompressed key
+	compact := ecdsa.SignCompact(key, Keccak256(msg), false)
+	sig := make([]byte, SignatureSize)
+	copy(sig, compact[1:])
+	sig[64] = compact[0] - compactRecoveryCodeOffset
+	return sig, nil
+}
+
+// RecoverPublicKey returns the 64 bytes x || y public key which produced sig over keccak256(msg)
+func RecoverPublicKey(msg []byte, sig []byte) ([]byte, error) {
+	if len(sig) != SignatureSize {
+		return nil, ErrInvalidSignature
+	}
+	recoveryId := sig[64]
+	if recoveryId > 3 {
+		return nil, ErrInvalidRecoveryId
+	}
+
+	compact := make([]byte, SignatureSize)
+	compact[0] = compactRecoveryCodeOffset + recoveryId
+	copy(compact[1:], sig[:64])
+	p, _, err := ecdsa.RecoverCompact(compact, Keccak256(msg))
+	if err != nil {
+		return nil, ErrInvalidSignature
+	}
+	return encodePublicKey(p), nil
+}
+
+// isLowS reports whether the s of an r || s || recovery id signature is at most half the curve order
+func isLowS(sig []byte) bool {
+	var s secp256k1.ModNScalar
+	if overflow := s.SetByteSlice(sig[32:64]); overflow {
+		return false
+	}
+	return !s.IsOverHalfOrder()
+}
+
+func parsePrivateKey(privateKey []byte) (*secp256k1.PrivateKey, error) {
+	if len(privateKey) != PrivateKeySize {
+		return nil, ErrInvalidPrivateKey
+	}
+	var d secp256k1.ModNScalar
+	if overflow := d.SetByteSlice(privateKey); overflow || d.IsZero() {
+		return nil, ErrInvalidPrivateKey
+	}
+	return secp256k1.NewPrivateKey(&d), nil
+}
+
+func parsePublicKey(publicKey []byte) (*secp256k1.PublicKey, error) {
+	switch {
+	case len(publicKey) == PublicKeySize:
+		publicKey = append([]byte{secp256k1.PubKeyFormatUncompressed}, publicKey...)
+	case len(publicKey) == PublicKeySize+1 && publicKey[0] == secp256k1.PubKeyFormatUncompressed:
+	case len(publicKey) == secp256k1.PubKeyBytesLenCompressed:
+	default:
+		return nil, ErrInvalidPublicKey
+	}
+	p, err := secp256k1.ParsePubKey(publicKey)
+	if err != nil {
+		return nil, ErrInvalidPublicKey
+	}
+	return p, nil
+}
+
+func encodePublicKey(p *secp256k1.PublicKey) []byte {
+	return p.SerializeUncompressed()[1:]
+}
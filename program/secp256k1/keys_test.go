@@ -0,0 +1,257 @@
+package secp256k1
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"testing"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/qimeila/solana-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// the same key, address and signature as TestNewSecp256k1Instruction
+var (
+	testPrivateKey, _ = base64.StdEncoding.DecodeString("bNyQVhCtQ86p9CCtzVkrg3Fm6WJqiYb+dMO4HDtbl6o=")
+	testEthAddress, _ = base64.StdEncoding.DecodeString("rx8O5L8N25rze03Dr4YXi9E+/Ys=")
+	testSignature, _  = base64.StdEncoding.DecodeString("K2mYts9f1v1hJc2kp2nCTZ6hZ9dhoHfADHW9zUCBftFTeN1lYUZEgoUZrklfifnZeWUJUujShZKgYtzoKMaRCgE=")
+)
+
+func TestKeccak256(t *testing.T) {
+	assert.Equal(t, "c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470", hex.EncodeToString(Keccak256()))
+	assert.Equal(t, hex.EncodeToString(Keccak256([]byte("message"))), hex.EncodeToString(Keccak256([]byte("mes"), []byte("sage"))))
+}
+
+func TestEthAddressFromPublicKey(t *testing.T) {
+	one := make([]byte, 32)
+	one[31] = 1
+	pubkeyOfOne, err := PublicKeyFromPrivateKey(one)
+	assert.NoError(t, err)
+
+	pubkey, err := PublicKeyFromPrivateKey(testPrivateKey)
+	assert.NoError(t, err)
+
+	compressed := append([]byte{0x02 + pubkey[63]&1}, pubkey[:32]...)
+	offCurve := append([]byte{}, pubkey...)
+	offCurve[63] ^= 1
+
+	tests := []struct {
+		name      string
+		publicKey []byte
+		want      string
+		wantErr   error
+	}{
+		{
+			name:      "private key 1",
+			publicKey: pubkeyOfOne,
+			want:      "7e5f4552091a69125d5dfcb7b8c2659029395bdf",
+		},
+		{
+			name:      "raw",
+			publicKey: pubkey,
+			want:      hex.EncodeToString(testEthAddress),
+		},
+		{
+			name:      "uncompressed",
+			publicKey: append([]byte{0x04}, pubkey...),
+			want:      hex.EncodeToString(testEthAddress),
+		},
+		{
+			name:      "compressed",
+			publicKey: compressed,
+			want:      hex.EncodeToString(testEthAddress),
+		},
+		{
+			name:      "not on curve",
+			publicKey: offCurve,
+			wantErr:   ErrInvalidPublicKey,
+		},
+		{
+			name:      "invalid size",
+			publicKey: pubkey[:40],
+			wantErr:   ErrInvalidPublicKey,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := EthAddressFromPublicKey(tt.publicKey)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, hex.EncodeToString(got))
+		})
+	}
+}
+
+func TestSign(t *testing.T) {
+	sig, err := Sign(testPrivateKey, []byte("message"))
+	assert.NoError(t, err)
+	assert.Equal(t, testSignature, sig)
+
+	pubkey, err := RecoverPublicKey([]byte("message"), sig)
+	assert.NoError(t, err)
+	addr, err := EthAddressFromPublicKey(pubkey)
+	assert.NoError(t, err)
+	assert.Equal(t, testEthAddress, addr)
+
+	_, err = Sign(make([]byte, 32), []byte("message"))
+	assert.Equal(t, ErrInvalidPrivateKey, err)
+
+	_, err = Sign(bytes.Repeat([]byte{0xff}, 32), []byte("message"))
+	assert.Equal(t, ErrInvalidPrivateKey, err)
+}
+
+func TestRecoverPublicKey(t *testing.T) {
+	pubkey, err := PublicKeyFromPrivateKey(testPrivateKey)
+	assert.NoError(t, err)
+
+	withRecoveryId := func(id byte) []byte {
+		sig := append([]byte{}, testSignature...)
+		sig[64] = id
+		return sig
+	}
+
+	tests := []struct {
+		name    string
+		msg     []byte
+		sig     []byte
+		want    []byte
+		wantErr error
+	}{
+		{
+			msg:  []byte("message"),
+			sig:  testSignature,
+			want: pubkey,
+		},
+		{
+			msg:     []byte("message"),
+			sig:     withRecoveryId(4),
+			wantErr: ErrInvalidRecoveryId,
+		},
+		{
+			msg:     []byte("message"),
+			sig:     testSignature[:64],
+			wantErr: ErrInvalidSignature,
+		},
+		{
+			msg:     []byte("message"),
+			sig:     make([]byte, 65),
+			wantErr: ErrInvalidSignature,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RecoverPublicKey(tt.msg, tt.sig)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	// the wrong parity recovers some other key
+	got, err := RecoverPublicKey([]byte("message"), withRecoveryId(0))
+	assert.NoError(t, err)
+	assert.NotEqual(t, pubkey, got)
+}
+
+func TestVerifySecp256k1Instruction(t *testing.T) {
+	otherKey := make([]byte, 32)
+	otherKey[31] = 2
+	otherPubkey, err := PublicKeyFromPrivateKey(otherKey)
+	assert.NoError(t, err)
+	otherAddress, err := EthAddressFromPublicKey(otherPubkey)
+	assert.NoError(t, err)
+	otherSignature, err := Sign(otherKey, []byte("other"))
+	assert.NoError(t, err)
+
+	newInstruction := func(msgs, sigs, addrs [][]byte, index uint8) types.Instruction {
+		instr, err := NewSecp256k1Instruction(msgs, sigs, addrs, index)
+		assert.NoError(t, err)
+		return instr
+	}
+	memo := types.Instruction{Data: []byte("memo")}
+
+	// n - s with the flipped parity is the same signature with a high s, it still recovers the key
+	var s secp256k1.ModNScalar
+	s.SetByteSlice(testSignature[32:64])
+	s.Negate()
+	highS := append([]byte{}, testSignature...)
+	s.PutBytesUnchecked(highS[32:64])
+	highS[64] ^= 1
+	pubkey, err := RecoverPublicKey([]byte("message"), highS)
+	assert.NoError(t, err)
+	address, err := EthAddressFromPublicKey(pubkey)
+	assert.NoError(t, err)
+	assert.Equal(t, testEthAddress, address)
+
+	tests := []struct {
+		name         string
+		instructions []types.Instruction
+		index        int
+		wantErr      error
+	}{
+		{
+			name: "multiple signatures",
+			instructions: []types.Instruction{
+				newInstruction(
+					[][]byte{[]byte("message"), []byte("other")},
+					[][]byte{testSignature, otherSignature},
+					[][]byte{testEthAddress, otherAddress},
+					0,
+				),
+			},
+			index: 0,
+		},
+		{
+			name: "at a later index",
+			instructions: []types.Instruction{
+				memo,
+				newInstruction([][]byte{[]byte("message")}, [][]byte{testSignature}, [][]byte{testEthAddress}, 1),
+			},
+			index: 1,
+		},
+		{
+			name: "wrong instruction index",
+			instructions: []types.Instruction{
+				memo,
+				newInstruction([][]byte{[]byte("message")}, [][]byte{testSignature}, [][]byte{testEthAddress}, 0),
+			},
+			index:   1,
+			wantErr: ErrInvalidDataOffsets,
+		},
+		{
+			name: "wrong address",
+			instructions: []types.Instruction{
+				newInstruction([][]byte{[]byte("message")}, [][]byte{testSignature}, [][]byte{otherAddress}, 0),
+			},
+			index:   0,
+			wantErr: ErrInvalidSignature,
+		},
+		{
+			name: "wrong message",
+			instructions: []types.Instruction{
+				newInstruction([][]byte{[]byte("massage")}, [][]byte{testSignature}, [][]byte{testEthAddress}, 0),
+			},
+			index:   0,
+			wantErr: ErrInvalidSignature,
+		},
+		{
+			name: "high s",
+			instructions: []types.Instruction{
+				newInstruction([][]byte{[]byte("message")}, [][]byte{highS}, [][]byte{testEthAddress}, 0),
+			},
+			index:   0,
+			wantErr: ErrInvalidSignature,
+		},
+		{
+			name:         "truncated offsets",
+			instructions: []types.Instruction{{Data: []byte{1, 0, 0}}},
+			index:        0,
+			wantErr:      ErrInvalidInstructionDataSize,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := VerifySecp256k1Instruction(tt.instructions, tt.index)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package secp256k1
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/qimeila/solana-go-sdk/types"
+)
+
+// ParseSecp256k1Instruction reads the signature offsets out of a secp256k1 instruction's data
+func ParseSecp256k1Instruction(data []byte) ([]SecpSignatureOffsets, error) {
+	if len(data) < 1 {
+		return nil, ErrInvalidInstructionDataSize
+	}
+	n := int(data[0])
+	if n == 0 && len(data) > 1 {
+		return nil, ErrInvalidInstructionDataSize
+	}
+	if len(data) < 1+n*OffsetsSerializedSize {
+		return nil, ErrInvalidInstructionDataSize
+	}
+
+	offsets := make([]SecpSignatureOffsets, 0, n)
+	for i := 0; i < n; i++ {
+		b := data[1+i*OffsetsSerializedSize:]
+		offsets = append(offsets, SecpSignatureOffsets{
+			SignatureOffsets:           binary.LittleEndian.Uint16(b[0:]),
+			SignatureInstructionIndex:  b[2],
+			EthAddressOffset:           binary.LittleEndian.Uint16(b[3:]),
+			EthAddressInstructionIndex: b[5],
+			MessageDataOffset:          binary.LittleEndian.Uint16(b[6:]),
+			MessageDataSize:            binary.LittleEndian.Uint16(b[8:]),
+			MessageInstructionIndex:    b[10],
+		})
+	}
+	return offsets, nil
+}
+
+// VerifySecp256k1Instruction checks the secp256k1 instruction at index of instructions the same way the precompile does.
+// Every signature must recover to the eth address its offsets point at, and like the precompile a high s is rejected.
+func VerifySecp256k1Instruction(instructions []types.Instruction, index int) error {
+	if index < 0 || index >= len(instructions) {
+		return fmt.Errorf("instruction index %d out of range", index)
+	}
+	offsets, err := ParseSecp256k1Instruction(instructions[index].Data)
+	if err != nil {
+		return err
+	}
+
+	for i, o := range offsets {
+		signature, err := getDataSlice(instructions, o.SignatureInstructionIndex, o.SignatureOffsets, SignatureSize)
+		if err != nil {
+			return fmt.Errorf("signature %d: %w", i, err)
+		}
+		ethAddress, err := getDataSlice(instructions, o.EthAddressInstructionIndex, o.EthAddressOffset, EthAddressSize)
+		if err != nil {
+			return fmt.Errorf("signature %d: %w", i, err)
+		}
+		message, err := getDataSlice(instructions, o.MessageInstructionIndex, o.MessageDataOffset, int(o.MessageDataSize))
+		if err != nil {
+			return fmt.Errorf("signature %d: %w", i, err)
+		}
+
+		if !isLowS(signature) {
+			return fmt.Errorf("signature %d: %w", i, ErrInvalidSignature)
+		}
+		publicKey, err := RecoverPublicKey(message, signature)
+		if err != nil {
+			return fmt.Errorf("signature %d: %w", i, err)
+		}
+		recovered, err := EthAddressFromPublicKey(publicKey)
+		if err != nil {
+			return fmt.Errorf("signature %d: %w", i, err)
+		}
+		if !bytes.Equal(recovered, ethAddress) {
+			return fmt.Errorf("signature %d: %w", i, ErrInvalidSignature)
+		}
+	}
+	return nil
+}
+
+func getDataSlice(instructions []types.Instruction, instructionIndex uint8, offset uint16, size int) ([]byte, error) {
+	if int(instructionIndex) >= len(instructions) {
+		return nil, ErrInvalidDataOffsets
+	}
+	data := instructions[instructionIndex].Data
+	start := int(offset)
+	if start+size > len(data) {
+		return nil, ErrInvalidDataOffsets
+	}
+	return data[start : start+size], nil
+}
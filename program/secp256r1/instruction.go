@@ -0,0 +1,192 @@
+package secp256r1
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/asn1"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/qimeila/solana-go-sdk/common"
+	"github.com/qimeila/solana-go-sdk/internal/precompile"
+	"github.com/qimeila/solana-go-sdk/pkg/bincode"
+	"github.com/qimeila/solana-go-sdk/types"
+)
+
+const (
+	CompressedPublicKeySize = 33
+	SignatureSize           = 64
+	FieldSize               = 32
+	OffsetsSerializedSize   = precompile.OffsetsSerializedSize
+	SignatureOffsetsStart   = precompile.SignatureOffsetsStart
+	MaxSignatures           = 8
+
+	// CurrentInstructionIndex makes an offset point into the secp256r1 instruction itself
+	CurrentInstructionIndex = precompile.CurrentInstructionIndex
+)
+
+var (
+	curveOrder     = elliptic.P256().Params().N
+	halfCurveOrder = new(big.Int).Rsh(curveOrder, 1)
+)
+
+type SecpSignatureOffsets struct {
+	SignatureOffset           uint16
+	SignatureInstructionIndex uint16
+	PublicKeyOffset           uint16
+	PublicKeyInstructionIndex uint16
+	MessageDataOffset         uint16
+	MessageDataSize           uint16
+	MessageInstructionIndex   uint16
+}
+
+// DataStart returns where the data following the offsets begins in an instruction with n signatures
+func DataStart(n int) int {
+	return SignatureOffsetsStart + n*OffsetsSerializedSize
+}
+
+// NewSecp256r1Instruction builds an instruction which carries every message, signature and public key itself.
+// sigs are 64 bytes r || s with a low s, pubkeys are 33 bytes compressed points.
+func NewSecp256r1Instruction(msgs [][]byte, sigs [][]byte, pubkeys [][]byte) (types.Instruction, error) {
+	if len(msgs) != len(sigs) || len(sigs) != len(pubkeys) {
+		return types.Instruction{}, fmt.Errorf("provided a different number of keys, messages, or signatures")
+	}
+
+	n := len(msgs)
+	offsets := make([]SecpSignatureOffsets, 0, n)
+	data := []byte{}
+	dataStart := DataStart(n)
+	for i, msg := range msgs {
+		if len(pubkeys[i]) != CompressedPublicKeySize {
+			return types.Instruction{}, fmt.Errorf("signature %d: %w", i, ErrInvalidPublicKey)
+		}
+		if len(sigs[i]) != SignatureSize {
+			return types.Instruction{}, fmt.Errorf("signature %d: %w", i, ErrInvalidSignature)
+		}
+
+		publicKeyOffset := dataStart + len(data)
+		data = append(data, pubkeys[i]...)
+		signatureOffset := dataStart + len(data)
+		data = append(data, sigs[i]...)
+		messageOffset := dataStart + len(data)
+		data = append(data, msg...)
+
+		if dataStart+len(data) > math.MaxUint16 {
+			return types.Instruction{}, ErrInstructionDataTooLarge
+		}
+
+		offsets = append(offsets, SecpSignatureOffsets{
+			SignatureOffset:           uint16(signatureOffset),
+			SignatureInstructionIndex: CurrentInstructionIndex,
+			PublicKeyOffset:           uint16(publicKeyOffset),
+			PublicKeyInstructionIndex: CurrentInstructionIndex,
+			MessageDataOffset:         uint16(messageOffset),
+			MessageDataSize:           uint16(len(msg)),
+			MessageInstructionIndex:   CurrentInstructionIndex,
+		})
+	}
+
+	return NewSecp256r1InstructionWithOffsets(offsets, data)
+}
+
+// NewSecp256r1InstructionWithOffsets builds an instruction from raw offsets, data is placed right after them.
+// Offsets with CurrentInstructionIndex are relative to the start of this instruction's data, use DataStart to find where data lands.
+func NewSecp256r1InstructionWithOffsets(offsets []SecpSignatureOffsets, data []byte) (types.Instruction, error) {
+	if len(offsets) > MaxSignatures {
+		return types.Instruction{}, ErrTooManySignatures
+	}
+
+	instrData := make([]byte, 0, DataStart(len(offsets))+len(data))
+	instrData = append(instrData, uint8(len(offsets)), 0) // count of offsets, padding
+	for _, o := range offsets {
+		b, err := bincode.SerializeData(o)
+		if err != nil {
+			return types.Instruction{}, err
+		}
+		instrData = append(instrData, b...)
+	}
+	instrData = append(instrData, data...)
+
+	return types.Instruction{
+		ProgramID: common.Secp256r1ProgramID,
+		Data:      instrData,
+	}, nil
+}
+
+// NewSecp256r1InstructionFromKeys signs each message with the key at the same index and builds the instruction
+func NewSecp256r1InstructionFromKeys(msgs [][]byte, keys []*ecdsa.PrivateKey) (types.Instruction, error) {
+	if len(msgs) != len(keys) {
+		return types.Instruction{}, fmt.Errorf("provided a different number of keys and messages")
+	}
+
+	sigs := make([][]byte, 0, len(msgs))
+	pubkeys := make([][]byte, 0, len(msgs))
+	for i, msg := range msgs {
+		sig, err := Sign(keys[i], msg)
+		if err != nil {
+			return types.Instruction{}, err
+		}
+		pubkey, err := CompressPublicKey(&keys[i].PublicKey)
+		if err != nil {
+			return types.Instruction{}, err
+		}
+		sigs = append(sigs, sig)
+		pubkeys = append(pubkeys, pubkey)
+	}
+
+	return NewSecp256r1Instruction(msgs, sigs, pubkeys)
+}
+
+// CompressPublicKey encodes a P-256 public key as the precompile expects it
+func CompressPublicKey(pub *ecdsa.PublicKey) ([]byte, error) {
+	if pub == nil || pub.Curve != elliptic.P256() {
+		return nil, ErrInvalidPublicKey
+	}
+	return elliptic.MarshalCompressed(pub.Curve, pub.X, pub.Y), nil
+}
+
+// Sign signs sha256(msg) and returns the 64 bytes r || s with a low s
+func Sign(key *ecdsa.PrivateKey, msg []byte) ([]byte, error) {
+	if key == nil || key.Curve != elliptic.P256() {
+		return nil, ErrInvalidPublicKey
+	}
+	hash := sha256.Sum256(msg)
+	r, s, err := ecdsa.Sign(rand.Reader, key, hash[:])
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign, err: %v", err)
+	}
+	return encodeSignature(r, s), nil
+}
+
+// SignatureFromASN1 converts a DER encoded ECDSA signature to r || s with a low s.
+// The precompile rejects a high s so it is replaced by its complement, which verifies the same.
+func SignatureFromASN1(der []byte) ([]byte, error) {
+	var sig struct {
+		R, S *big.Int
+	}
+	rest, err := asn1.Unmarshal(der, &sig)
+	if err != nil || len(rest) != 0 {
+		return nil, ErrInvalidSignature
+	}
+	if !inRange(sig.R) || !inRange(sig.S) {
+		return nil, ErrInvalidSignature
+	}
+	return encodeSignature(sig.R, sig.S), nil
+}
+
+func encodeSignature(r, s *big.Int) []byte {
+	if s.Cmp(halfCurveOrder) > 0 {
+		s = new(big.Int).Sub(curveOrder, s)
+	}
+	sig := make([]byte, SignatureSize)
+	r.FillBytes(sig[:FieldSize])
+	s.FillBytes(sig[FieldSize:])
+	return sig
+}
+
+func inRange(v *big.Int) bool {
+	return v.Sign() > 0 && v.Cmp(curveOrder) < 0
+}
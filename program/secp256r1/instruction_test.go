@@ -0,0 +1,267 @@
+package secp256r1
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/asn1"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/qimeila/solana-go-sdk/common"
+	"github.com/qimeila/solana-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func testKey(d int64) *ecdsa.PrivateKey {
+	key := &ecdsa.PrivateKey{D: big.NewInt(d)}
+	key.PublicKey.Curve = elliptic.P256()
+	key.PublicKey.X, key.PublicKey.Y = elliptic.P256().ScalarBaseMult(key.D.Bytes())
+	return key
+}
+
+func TestCompressPublicKey(t *testing.T) {
+	got, err := CompressPublicKey(&testKey(1).PublicKey)
+	assert.NoError(t, err)
+	// the generator of P-256
+	assert.Equal(t, "036b17d1f2e12c4247f8bce6e563a440f277037d812deb33a0f4a13945d898c296", hex.EncodeToString(got))
+}
+
+func TestNewSecp256r1InstructionFromKeys(t *testing.T) {
+	key := testKey(1)
+	instr, err := NewSecp256r1InstructionFromKeys([][]byte{[]byte("message")}, []*ecdsa.PrivateKey{key})
+	assert.NoError(t, err)
+	assert.Equal(t, common.Secp256r1ProgramID, instr.ProgramID)
+	assert.Empty(t, instr.Accounts)
+
+	pubkey, _ := CompressPublicKey(&key.PublicKey)
+	assert.Equal(t, []byte{
+		1, 0,
+		0x31, 0, 0xff, 0xff, // signature
+		0x10, 0, 0xff, 0xff, // public key
+		0x71, 0, 7, 0, 0xff, 0xff, // message
+	}, instr.Data[:DataStart(1)])
+	assert.Equal(t, pubkey, instr.Data[16:49])
+	assert.Equal(t, []byte("message"), instr.Data[113:])
+
+	assert.NoError(t, VerifySecp256r1Instruction([]types.Instruction{instr}, 0))
+}
+
+func TestNewSecp256r1InstructionInvalidParam(t *testing.T) {
+	key := testKey(1)
+	pubkey, _ := CompressPublicKey(&key.PublicKey)
+	sig, _ := Sign(key, []byte("message"))
+
+	tests := []struct {
+		name    string
+		msgs    [][]byte
+		sigs    [][]byte
+		pubkeys [][]byte
+		wantErr error
+	}{
+		{
+			name:    "uncompressed public key",
+			msgs:    [][]byte{[]byte("message")},
+			sigs:    [][]byte{sig},
+			pubkeys: [][]byte{elliptic.Marshal(elliptic.P256(), key.X, key.Y)},
+			wantErr: ErrInvalidPublicKey,
+		},
+		{
+			name:    "short signature",
+			msgs:    [][]byte{[]byte("message")},
+			sigs:    [][]byte{sig[:63]},
+			pubkeys: [][]byte{pubkey},
+			wantErr: ErrInvalidSignature,
+		},
+		{
+			name:    "too many signatures",
+			msgs:    [][]byte{{1}, {2}, {3}, {4}, {5}, {6}, {7}, {8}, {9}},
+			sigs:    [][]byte{sig, sig, sig, sig, sig, sig, sig, sig, sig},
+			pubkeys: [][]byte{pubkey, pubkey, pubkey, pubkey, pubkey, pubkey, pubkey, pubkey, pubkey},
+			wantErr: ErrTooManySignatures,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewSecp256r1Instruction(tt.msgs, tt.sigs, tt.pubkeys)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestNewSecp256r1InstructionFromWebAuthn(t *testing.T) {
+	key := testKey(12345)
+	authenticatorData := make([]byte, 37)
+	authenticatorData[32] = 0x05 // user present, user verified
+	clientDataJSON := []byte(`{"type":"webauthn.get","challenge":"c29sYW5h","origin":"https://example.com"}`)
+
+	hash := sha256.Sum256(WebAuthnMessage(authenticatorData, clientDataJSON))
+	r, s, err := ecdsa.Sign(rand.Reader, key, hash[:])
+	assert.NoError(t, err)
+	encode := func(r, s *big.Int) []byte {
+		der, err := asn1.Marshal(struct{ R, S *big.Int }{r, s})
+		assert.NoError(t, err)
+		return der
+	}
+	highS := s
+	if s.Cmp(halfCurveOrder) <= 0 {
+		highS = new(big.Int).Sub(curveOrder, s)
+	}
+
+	tests := []struct {
+		name      string
+		assertion WebAuthnAssertion
+		wantErr   error
+	}{
+		{
+			name: "high s is normalized",
+			assertion: WebAuthnAssertion{
+				PublicKey:         &key.PublicKey,
+				AuthenticatorData: authenticatorData,
+				ClientDataJSON:    clientDataJSON,
+				Signature:         encode(r, highS),
+			},
+		},
+		{
+			name: "low s",
+			assertion: WebAuthnAssertion{
+				PublicKey:         &key.PublicKey,
+				AuthenticatorData: authenticatorData,
+				ClientDataJSON:    clientDataJSON,
+				Signature:         encode(r, new(big.Int).Sub(curveOrder, highS)),
+			},
+		},
+		{
+			name: "malformed signature",
+			assertion: WebAuthnAssertion{
+				PublicKey:         &key.PublicKey,
+				AuthenticatorData: authenticatorData,
+				ClientDataJSON:    clientDataJSON,
+				Signature:         []byte{0x30, 0x01},
+			},
+			wantErr: ErrInvalidSignature,
+		},
+		{
+			name: "missing public key",
+			assertion: WebAuthnAssertion{
+				AuthenticatorData: authenticatorData,
+				ClientDataJSON:    clientDataJSON,
+				Signature:         encode(r, s),
+			},
+			wantErr: ErrInvalidPublicKey,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			instr, err := NewSecp256r1InstructionFromWebAuthn([]WebAuthnAssertion{tt.assertion})
+			assert.ErrorIs(t, err, tt.wantErr)
+			if err != nil {
+				return
+			}
+			assert.NoError(t, VerifySecp256r1Instruction([]types.Instruction{instr}, 0))
+
+			offsets, err := ParseSecp256r1Instruction(instr.Data)
+			assert.NoError(t, err)
+			sig := instr.Data[offsets[0].SignatureOffset : offsets[0].SignatureOffset+SignatureSize]
+			assert.True(t, new(big.Int).SetBytes(sig[FieldSize:]).Cmp(halfCurveOrder) <= 0)
+		})
+	}
+}
+
+func TestVerifySecp256r1Instruction(t *testing.T) {
+	key1, key2 := testKey(1), testKey(2)
+	multi, err := NewSecp256r1InstructionFromKeys([][]byte{[]byte("a"), []byte("bc")}, []*ecdsa.PrivateKey{key1, key2})
+	assert.NoError(t, err)
+
+	// the public key and signature are carried by the secp256r1 instruction, the message is the memo at index 0
+	message := []byte("order: buy 10 @ 1.5")
+	memo := types.Instruction{ProgramID: common.MemoProgramID, Data: message}
+	pubkey, _ := CompressPublicKey(&key1.PublicKey)
+	sig, err := Sign(key1, message)
+	assert.NoError(t, err)
+	crossInstruction := func(messageInstructionIndex uint16, messageSize uint16) types.Instruction {
+		instr, err := NewSecp256r1InstructionWithOffsets(
+			[]SecpSignatureOffsets{
+				{
+					PublicKeyOffset:           uint16(DataStart(1)),
+					PublicKeyInstructionIndex: CurrentInstructionIndex,
+					SignatureOffset:           uint16(DataStart(1) + CompressedPublicKeySize),
+					SignatureInstructionIndex: CurrentInstructionIndex,
+					MessageDataOffset:         0,
+					MessageDataSize:           messageSize,
+					MessageInstructionIndex:   messageInstructionIndex,
+				},
+			},
+			append(append([]byte{}, pubkey...), sig...),
+		)
+		assert.NoError(t, err)
+		return instr
+	}
+
+	highS := append([]byte{}, sig...)
+	s := new(big.Int).Sub(curveOrder, new(big.Int).SetBytes(sig[FieldSize:]))
+	s.FillBytes(highS[FieldSize:])
+	withHighS, err := NewSecp256r1Instruction([][]byte{message}, [][]byte{highS}, [][]byte{pubkey})
+	assert.NoError(t, err)
+
+	badPubkey := append([]byte{}, pubkey...)
+	badPubkey[0] = 0x05
+	withBadPubkey, err := NewSecp256r1Instruction([][]byte{message}, [][]byte{sig}, [][]byte{badPubkey})
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		instructions []types.Instruction
+		index        int
+		wantErr      error
+	}{
+		{
+			name:         "multiple signatures",
+			instructions: []types.Instruction{multi},
+			index:        0,
+		},
+		{
+			name:         "message in another instruction",
+			instructions: []types.Instruction{memo, crossInstruction(0, uint16(len(message)))},
+			index:        1,
+		},
+		{
+			name:         "message instruction out of range",
+			instructions: []types.Instruction{memo, crossInstruction(2, uint16(len(message)))},
+			index:        1,
+			wantErr:      ErrInvalidDataOffsets,
+		},
+		{
+			name:         "wrong message",
+			instructions: []types.Instruction{memo, crossInstruction(0, uint16(len(message)-1))},
+			index:        1,
+			wantErr:      ErrInvalidSignature,
+		},
+		{
+			name:         "high s",
+			instructions: []types.Instruction{withHighS},
+			index:        0,
+			wantErr:      ErrInvalidSignature,
+		},
+		{
+			name:         "invalid public key",
+			instructions: []types.Instruction{withBadPubkey},
+			index:        0,
+			wantErr:      ErrInvalidPublicKey,
+		},
+		{
+			name:         "no signatures",
+			instructions: []types.Instruction{{ProgramID: common.Secp256r1ProgramID, Data: []byte{0, 0}}},
+			index:        0,
+			wantErr:      ErrInvalidInstructionDataSize,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := VerifySecp256r1Instruction(tt.instructions, tt.index)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package secp256r1
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"fmt"
+	"math/big"
+
+	"github.com/qimeila/solana-go-sdk/internal/precompile"
+	"github.com/qimeila/solana-go-sdk/types"
+)
+
+// ParseSecp256r1Instruction reads the signature offsets out of a secp256r1 instruction's data
+func ParseSecp256r1Instruction(data []byte) ([]SecpSignatureOffsets, error) {
+	if len(data) < SignatureOffsetsStart {
+		return nil, ErrInvalidInstructionDataSize
+	}
+	n := int(data[0])
+	if n == 0 || n > MaxSignatures {
+		return nil, ErrInvalidInstructionDataSize
+	}
+	parsed, ok := precompile.ParseOffsets(data, n)
+	if !ok {
+		return nil, ErrInvalidInstructionDataSize
+	}
+
+	offsets := make([]SecpSignatureOffsets, 0, n)
+	for _, o := range parsed {
+		offsets = append(offsets, SecpSignatureOffsets(o))
+	}
+	return offsets, nil
+}
+
+// VerifySecp256r1Instruction checks the secp256r1 instruction at index of instructions the same way the precompile does
+func VerifySecp256r1Instruction(instructions []types.Instruction, index int) error {
+	if index < 0 || index >= len(instructions) {
+		return fmt.Errorf("instruction index %d out of range", index)
+	}
+	data := instructions[index].Data
+	offsets, err := ParseSecp256r1Instruction(data)
+	if err != nil {
+		return err
+	}
+
+	for i, o := range offsets {
+		signature, err := getDataSlice(data, instructions, o.SignatureInstructionIndex, o.SignatureOffset, SignatureSize)
+		if err != nil {
+			return fmt.Errorf("signature %d: %w", i, err)
+		}
+		publicKey, err := getDataSlice(data, instructions, o.PublicKeyInstructionIndex, o.PublicKeyOffset, CompressedPublicKeySize)
+		if err != nil {
+			return fmt.Errorf("signature %d: %w", i, err)
+		}
+		message, err := getDataSlice(data, instructions, o.MessageInstructionIndex, o.MessageDataOffset, int(o.MessageDataSize))
+		if err != nil {
+			return fmt.Errorf("signature %d: %w", i, err)
+		}
+
+		x, y := elliptic.UnmarshalCompressed(elliptic.P256(), publicKey)
+		if x == nil {
+			return fmt.Errorf("signature %d: %w", i, ErrInvalidPublicKey)
+		}
+		r := new(big.Int).SetBytes(signature[:FieldSize])
+		s := new(big.Int).SetBytes(signature[FieldSize:])
+		if !inRange(r) || !inRange(s) || s.Cmp(halfCurveOrder) > 0 {
+			return fmt.Errorf("signature %d: %w", i, ErrInvalidSignature)
+		}
+		hash := sha256.Sum256(message)
+		if !ecdsa.Verify(&ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}, hash[:], r, s) {
+			return fmt.Errorf("signature %d: %w", i, ErrInvalidSignature)
+		}
+	}
+	return nil
+}
+
+func getDataSlice(data []byte, instructions []types.Instruction, instructionIndex uint16, offset uint16, size int) ([]byte, error) {
+	b, ok := precompile.GetDataSlice(data, instructions, instructionIndex, offset, size)
+	if !ok {
+		return nil, ErrInvalidDataOffsets
+	}
+	return b, nil
+}
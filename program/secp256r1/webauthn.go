@@ -0,0 +1,48 @@
+package secp256r1
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/qimeila/solana-go-sdk/types"
+)
+
+// WebAuthnAssertion is what a passkey returns from navigator.credentials.get, together with the credential's public key
+type WebAuthnAssertion struct {
+	PublicKey         *ecdsa.PublicKey
+	AuthenticatorData []byte
+	ClientDataJSON    []byte
+	// Signature is DER encoded, as the authenticator returns it
+	Signature []byte
+}
+
+// WebAuthnMessage returns the bytes an authenticator signs, authenticatorData || sha256(clientDataJSON)
+func WebAuthnMessage(authenticatorData, clientDataJSON []byte) []byte {
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	msg := make([]byte, 0, len(authenticatorData)+len(clientDataHash))
+	msg = append(msg, authenticatorData...)
+	return append(msg, clientDataHash[:]...)
+}
+
+// NewSecp256r1InstructionFromWebAuthn builds an instruction which verifies passkey assertions
+func NewSecp256r1InstructionFromWebAuthn(assertions []WebAuthnAssertion) (types.Instruction, error) {
+	msgs := make([][]byte, 0, len(assertions))
+	sigs := make([][]byte, 0, len(assertions))
+	pubkeys := make([][]byte, 0, len(assertions))
+	for i, assertion := range assertions {
+		sig, err := SignatureFromASN1(assertion.Signature)
+		if err != nil {
+			return types.Instruction{}, fmt.Errorf("signature %d: %w", i, err)
+		}
+		pubkey, err := CompressPublicKey(assertion.PublicKey)
+		if err != nil {
+			return types.Instruction{}, fmt.Errorf("signature %d: %w", i, err)
+		}
+		msgs = append(msgs, WebAuthnMessage(assertion.AuthenticatorData, assertion.ClientDataJSON))
+		sigs = append(sigs, sig)
+		pubkeys = append(pubkeys, pubkey)
+	}
+
+	return NewSecp256r1Instruction(msgs, sigs, pubkeys)
+}
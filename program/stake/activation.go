@@ -0,0 +1,189 @@
+package stake
+
+import (
+	"math"
+
+	"github.com/qimeila/solana-go-sdk/program/sysvar"
+)
+
+const (
+	DefaultWarmupCooldownRate = 0.25
+	NewWarmupCooldownRate     = 0.09
+)
+
+// WarmupCooldownRate returns the share of the cluster's effective stake which can (de)activate in an epoch.
+// newRateActivationEpoch is the epoch the reduce_stake_warmup_cooldown feature was activated, nil if it is not active.
+func WarmupCooldownRate(currentEpoch uint64, newRateActivationEpoch *uint64) float64 {
+	if newRateActivationEpoch == nil || currentEpoch < *newRateActivationEpoch {
+		return DefaultWarmupCooldownRate
+	}
+	return NewWarmupCooldownRate
+}
+
+type StakeActivationStatus struct {
+	Effective    uint64
+	Activating   uint64
+	Deactivating uint64
+}
+
+// IsBootstrap reports whether the stake was delegated in genesis
+func (d Delegation) IsBootstrap() bool {
+	return d.ActivationEpoch == math.MaxUint64
+}
+
+// StakeActivatingAndDeactivating returns the effective, activating and deactivating stake at targetEpoch.
+// It follows the stake program so the result matches what the cluster computes.
+func (d Delegation) StakeActivatingAndDeactivating(targetEpoch uint64, history sysvar.StakeHistory, newRateActivationEpoch *uint64) StakeActivationStatus {
+	effectiveStake, activatingStake := d.stakeAndActivating(targetEpoch, history, newRateActivationEpoch)
+
+	if targetEpoch < d.DeactivationEpoch {
+		return StakeActivationStatus{Effective: effectiveStake, Activating: activatingStake}
+	}
+	if targetEpoch == d.DeactivationEpoch {
+		return StakeActivationStatus{Effective: effectiveStake, Deactivating: effectiveStake}
+	}
+
+	prevClusterStake, ok := history.Get(d.DeactivationEpoch)
+	if !ok {
+		return StakeActivationStatus{}
+	}
+	prevEpoch := d.DeactivationEpoch
+	currentEffectiveStake := effectiveStake
+	for {
+		currentEpoch := prevEpoch + 1
+		if prevClusterStake.Deactivating == 0 {
+			break
+		}
+
+		weight := float64(currentEffectiveStake) / float64(prevClusterStake.Deactivating)
+		newlyNotEffectiveClusterStake := float64(prevClusterStake.Effective) * WarmupCooldownRate(currentEpoch, newRateActivationEpoch)
+		newlyNotEffectiveStake := uint64(weight * newlyNotEffectiveClusterStake)
+		if newlyNotEffectiveStake < 1 {
+			newlyNotEffectiveStake = 1
+		}
+
+		if newlyNotEffectiveStake >= currentEffectiveStake {
+			currentEffectiveStake = 0
+			break
+		}
+		currentEffectiveStake -= newlyNotEffectiveStake
+
+		if currentEpoch >= targetEpoch {
+			break
+		}
+		currentClusterStake, ok := history.Get(currentEpoch)
+		if !ok {
+			break
+		}
+		prevEpoch = currentEpoch
+		prevClusterStake = currentClusterStake
+	}
+
+	return StakeActivationStatus{Effective: currentEffectiveStake, Deactivating: currentEffectiveStake}
+}
+
+func (d Delegation) stakeAndActivating(targetEpoch uint64, history sysvar.StakeHistory, newRateActivationEpoch *uint64) (uint64, uint64) {
+	delegatedStake := d.Stake
+
+	switch {
+	case d.IsBootstrap():
+		return delegatedStake, 0
+	case d.ActivationEpoch == d.DeactivationEpoch:
+		// deactivated in the same epoch it was activated, it never takes effect
+		return 0, 0
+	case targetEpoch == d.ActivationEpoch:
+		return 0, delegatedStake
+	case targetEpoch < d.ActivationEpoch:
+		return 0, 0
+	}
+
+	prevClusterStake, ok := history.Get(d.ActivationEpoch)
+	if !ok {
+		// no history or the activation epoch has dropped out of it, so assume it is fully effective
+		return delegatedStake, 0
+	}
+	prevEpoch := d.ActivationEpoch
+	currentEffectiveStake := uint64(0)
+	for {
+		currentEpoch := prevEpoch + 1
+		if prevClusterStake.Activating == 0 {
+			break
+		}
+
+		remainingActivatingStake := delegatedStake - currentEffectiveStake
+		weight := float64(remainingActivatingStake) / float64(prevClusterStake.Activating)
+		newlyEffectiveClusterStake := float64(prevClusterStake.Effective) * WarmupCooldownRate(currentEpoch, newRateActivationEpoch)
+		newlyEffectiveStake := uint64(weight * newlyEffectiveClusterStake)
+		if newlyEffectiveStake < 1 {
+			newlyEffectiveStake = 1
+		}
+
+		currentEffectiveStake += newlyEffectiveStake
+		if currentEffectiveStake >= delegatedStake {
+			currentEffectiveStake = delegatedStake
+			break
+		}
+
+		if currentEpoch >= targetEpoch || currentEpoch >= d.DeactivationEpoch {
+			break
+		}
+		currentClusterStake, ok := history.Get(currentEpoch)
+		if !ok {
+			break
+		}
+		prevEpoch = currentEpoch
+		prevClusterStake = currentClusterStake
+	}
+
+	return currentEffectiveStake, delegatedStake - currentEffectiveStake
+}
+
+type StakeActivationState string
+
+const (
+	StakeActivationStateActive       StakeActivationState = "active"
+	StakeActivationStateInactive     StakeActivationState = "inactive"
+	StakeActivationStateActivating   StakeActivationState = "activating"
+	StakeActivationStateDeactivating StakeActivationState = "deactivating"
+)
+
+// StakeActivation is what the removed getStakeActivation rpc method used to return
+type StakeActivation struct {
+	State    StakeActivationState
+	Active   uint64
+	Inactive uint64
+}
+
+// GetStakeActivation computes the activation of a stake account at epoch, lamports is the account's balance.
+func GetStakeActivation(state StakeStateV2, lamports uint64, epoch uint64, history sysvar.StakeHistory, newRateActivationEpoch *uint64) StakeActivation {
+	var rentExemptReserve uint64
+	if state.Meta != nil {
+		rentExemptReserve = state.Meta.RentExemptReserve
+	}
+
+	var status StakeActivationStatus
+	if state.Stake != nil {
+		status = state.Stake.Delegation.StakeActivatingAndDeactivating(epoch, history, newRateActivationEpoch)
+	}
+
+	var inactive uint64
+	if lamports > rentExemptReserve+status.Effective {
+		inactive = lamports - rentExemptReserve - status.Effective
+	}
+
+	activationState := StakeActivationStateInactive
+	switch {
+	case status.Deactivating > 0:
+		activationState = StakeActivationStateDeactivating
+	case status.Activating > 0:
+		activationState = StakeActivationStateActivating
+	case status.Effective > 0:
+		activationState = StakeActivationStateActive
+	}
+
+	return StakeActivation{
+		State:    activationState,
+		Active:   status.Effective,
+		Inactive: inactive,
+	}
+}
@@ -0,0 +1,118 @@
+package stake
+
+import (
+	"math"
+	"testing"
+
+	"github.com/qimeila/solana-go-sdk/pkg/pointer"
+	"github.com/qimeila/solana-go-sdk/program/sysvar"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDelegation_StakeActivatingAndDeactivating(t *testing.T) {
+	history := sysvar.StakeHistory{
+		{Epoch: 21, StakeHistoryEntry: sysvar.StakeHistoryEntry{Effective: 3500, Deactivating: 500}},
+		{Epoch: 20, StakeHistoryEntry: sysvar.StakeHistoryEntry{Effective: 4000, Deactivating: 2000}},
+		{Epoch: 11, StakeHistoryEntry: sysvar.StakeHistoryEntry{Effective: 2500, Activating: 500}},
+		{Epoch: 10, StakeHistoryEntry: sysvar.StakeHistoryEntry{Effective: 2000, Activating: 1000}},
+	}
+	activating := Delegation{Stake: 1000, ActivationEpoch: 10, DeactivationEpoch: math.MaxUint64}
+	deactivating := Delegation{Stake: 1000, ActivationEpoch: 0, DeactivationEpoch: 20}
+
+	type args struct {
+		delegation             Delegation
+		targetEpoch            uint64
+		newRateActivationEpoch *uint64
+	}
+	tests := []struct {
+		name string
+		args args
+		want StakeActivationStatus
+	}{
+		{
+			name: "before activation",
+			args: args{delegation: activating, targetEpoch: 9},
+			want: StakeActivationStatus{},
+		},
+		{
+			name: "activation epoch",
+			args: args{delegation: activating, targetEpoch: 10},
+			want: StakeActivationStatus{Activating: 1000},
+		},
+		{
+			name: "warming up",
+			args: args{delegation: activating, targetEpoch: 11},
+			want: StakeActivationStatus{Effective: 500, Activating: 500},
+		},
+		{
+			name: "warming up with the new rate",
+			args: args{delegation: activating, targetEpoch: 11, newRateActivationEpoch: pointer.Get[uint64](11)},
+			want: StakeActivationStatus{Effective: 180, Activating: 820},
+		},
+		{
+			name: "fully active",
+			args: args{delegation: activating, targetEpoch: 12},
+			want: StakeActivationStatus{Effective: 1000},
+		},
+		{
+			name: "bootstrap",
+			args: args{delegation: Delegation{Stake: 1000, ActivationEpoch: math.MaxUint64, DeactivationEpoch: math.MaxUint64}, targetEpoch: 0},
+			want: StakeActivationStatus{Effective: 1000},
+		},
+		{
+			name: "activated and deactivated in the same epoch",
+			args: args{delegation: Delegation{Stake: 1000, ActivationEpoch: 10, DeactivationEpoch: 10}, targetEpoch: 10},
+			want: StakeActivationStatus{},
+		},
+		{
+			name: "deactivation epoch",
+			args: args{delegation: deactivating, targetEpoch: 20},
+			want: StakeActivationStatus{Effective: 1000, Deactivating: 1000},
+		},
+		{
+			name: "cooling down",
+			args: args{delegation: deactivating, targetEpoch: 21},
+			want: StakeActivationStatus{Effective: 500, Deactivating: 500},
+		},
+		{
+			name: "fully deactivated",
+			args: args{delegation: deactivating, targetEpoch: 22},
+			want: StakeActivationStatus{},
+		},
+		{
+			name: "deactivation epoch not in history",
+			args: args{delegation: Delegation{Stake: 1000, ActivationEpoch: 0, DeactivationEpoch: 30}, targetEpoch: 31},
+			want: StakeActivationStatus{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.args.delegation.StakeActivatingAndDeactivating(tt.args.targetEpoch, history, tt.args.newRateActivationEpoch)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGetStakeActivation(t *testing.T) {
+	history := sysvar.StakeHistory{
+		{Epoch: 10, StakeHistoryEntry: sysvar.StakeHistoryEntry{Effective: 2000, Activating: 1000}},
+	}
+	state := StakeStateV2{
+		Type:  StakeStateStake,
+		Meta:  &Meta{RentExemptReserve: 2282880},
+		Stake: &Stake{Delegation: Delegation{Stake: 1000, ActivationEpoch: 10, DeactivationEpoch: math.MaxUint64}},
+	}
+
+	assert.Equal(t,
+		StakeActivation{State: StakeActivationStateActivating, Active: 500, Inactive: 505},
+		GetStakeActivation(state, 2282880+1005, 11, history, nil),
+	)
+	assert.Equal(t,
+		StakeActivation{State: StakeActivationStateInactive, Active: 0, Inactive: 1005},
+		GetStakeActivation(state, 2282880+1005, 9, history, nil),
+	)
+	assert.Equal(t,
+		StakeActivation{State: StakeActivationStateInactive, Active: 0, Inactive: 1000},
+		GetStakeActivation(StakeStateV2{Type: StakeStateInitialized, Meta: &Meta{RentExemptReserve: 2282880}}, 2282880+1000, 11, history, nil),
+	)
+}
@@ -251,3 +251,366 @@ func TestSetLockup(t *testing.T) {
 		})
 	}
 }
+
+func TestInitializeChecked(t *testing.T) {
+	type args struct {
+		param InitializeCheckedParam
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Instruction
+	}{
+		{
+			args: args{
+				param: InitializeCheckedParam{
+					Stake:      common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"),
+					Staker:     common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"),
+					Withdrawer: common.PublicKeyFromString("DuNVVSmxNkXZvzBXQqNv8Q5nfvd4WJRvZ8yRFyGRUSXm"),
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.StakeProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"), IsSigner: false, IsWritable: true},
+					{PubKey: common.SysVarRentPubkey, IsSigner: false, IsWritable: false},
+					{PubKey: common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"), IsSigner: false, IsWritable: false},
+					{PubKey: common.PublicKeyFromString("DuNVVSmxNkXZvzBXQqNv8Q5nfvd4WJRvZ8yRFyGRUSXm"), IsSigner: true, IsWritable: false},
+				},
+				Data: []byte{9, 0, 0, 0},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := InitializeChecked(tt.args.param); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("InitializeChecked() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAuthorizeChecked(t *testing.T) {
+	type args struct {
+		param AuthorizeCheckedParam
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Instruction
+	}{
+		{
+			args: args{
+				param: AuthorizeCheckedParam{
+					Stake:    common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"),
+					Auth:     common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"),
+					NewAuth:  common.PublicKeyFromString("DuNVVSmxNkXZvzBXQqNv8Q5nfvd4WJRvZ8yRFyGRUSXm"),
+					AuthType: StakeAuthorizationTypeWithdrawer,
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.StakeProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"), IsSigner: false, IsWritable: true},
+					{PubKey: common.SysVarClockPubkey, IsSigner: false, IsWritable: false},
+					{PubKey: common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"), IsSigner: true, IsWritable: false},
+					{PubKey: common.PublicKeyFromString("DuNVVSmxNkXZvzBXQqNv8Q5nfvd4WJRvZ8yRFyGRUSXm"), IsSigner: true, IsWritable: false},
+				},
+				Data: []byte{10, 0, 0, 0, 1, 0, 0, 0},
+			},
+		},
+		{
+			args: args{
+				param: AuthorizeCheckedParam{
+					Stake:     common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"),
+					Auth:      common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"),
+					NewAuth:   common.PublicKeyFromString("DuNVVSmxNkXZvzBXQqNv8Q5nfvd4WJRvZ8yRFyGRUSXm"),
+					AuthType:  StakeAuthorizationTypeStaker,
+					Custodian: pointer.Get[common.PublicKey](common.PublicKeyFromString("Gx6FKjrt1EbBKsA8DFSgkj6egv8R5AoATBk1j2J3GHxU")),
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.StakeProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"), IsSigner: false, IsWritable: true},
+					{PubKey: common.SysVarClockPubkey, IsSigner: false, IsWritable: false},
+					{PubKey: common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"), IsSigner: true, IsWritable: false},
+					{PubKey: common.PublicKeyFromString("DuNVVSmxNkXZvzBXQqNv8Q5nfvd4WJRvZ8yRFyGRUSXm"), IsSigner: true, IsWritable: false},
+					{PubKey: common.PublicKeyFromString("Gx6FKjrt1EbBKsA8DFSgkj6egv8R5AoATBk1j2J3GHxU"), IsSigner: true, IsWritable: false},
+				},
+				Data: []byte{10, 0, 0, 0, 0, 0, 0, 0},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := AuthorizeChecked(tt.args.param); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AuthorizeChecked() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAuthorizeCheckedWithSeed(t *testing.T) {
+	type args struct {
+		param AuthorizeCheckedWithSeedParam
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Instruction
+	}{
+		{
+			args: args{
+				param: AuthorizeCheckedWithSeedParam{
+					Stake:     common.PublicKeyFromString("6JNQUmE1MdB4E1Caj2A443Za15ju2XFCyjumnddjeNrP"),
+					AuthBase:  common.PublicKeyFromString("Gx6FKjrt1EbBKsA8DFSgkj6egv8R5AoATBk1j2J3GHxU"),
+					AuthSeed:  "any seed here",
+					AuthOwner: common.PublicKeyFromString("Stake11111111111111111111111111111111111111"),
+					NewAuth:   common.PublicKeyFromString("RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7"),
+					AuthType:  StakeAuthorizationTypeStaker,
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.StakeProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("6JNQUmE1MdB4E1Caj2A443Za15ju2XFCyjumnddjeNrP"), IsSigner: false, IsWritable: true},
+					{PubKey: common.PublicKeyFromString("Gx6FKjrt1EbBKsA8DFSgkj6egv8R5AoATBk1j2J3GHxU"), IsSigner: true, IsWritable: false},
+					{PubKey: common.SysVarClockPubkey, IsSigner: false, IsWritable: false},
+					{PubKey: common.PublicKeyFromString("RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7"), IsSigner: true, IsWritable: false},
+				},
+				Data: []byte{11, 0, 0, 0, 0, 0, 0, 0, 13, 0, 0, 0, 0, 0, 0, 0, 97, 110, 121, 32, 115, 101, 101, 100, 32, 104, 101, 114, 101, 6, 161, 216, 23, 145, 55, 84, 42, 152, 52, 55, 189, 254, 42, 122, 178, 85, 127, 83, 92, 138, 120, 114, 43, 104, 164, 157, 192, 0, 0, 0, 0},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := AuthorizeCheckedWithSeed(tt.args.param); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AuthorizeCheckedWithSeed() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetLockupChecked(t *testing.T) {
+	type args struct {
+		param SetLockupCheckedParam
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Instruction
+	}{
+		{
+			args: args{
+				param: SetLockupCheckedParam{
+					Stake: common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"),
+					Auth:  common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"),
+					Lockup: LockupCheckedParam{
+						Epoch: pointer.Get[uint64](2),
+					},
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.StakeProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"), IsSigner: false, IsWritable: true},
+					{PubKey: common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"), IsSigner: true, IsWritable: false},
+				},
+				Data: []byte{12, 0, 0, 0, 0, 1, 2, 0, 0, 0, 0, 0, 0, 0},
+			},
+		},
+		{
+			args: args{
+				param: SetLockupCheckedParam{
+					Stake: common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"),
+					Auth:  common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"),
+					Lockup: LockupCheckedParam{
+						UnixTimestamp: pointer.Get[int64](1),
+					},
+					NewCustodian: pointer.Get[common.PublicKey](common.PublicKeyFromString("DuNVVSmxNkXZvzBXQqNv8Q5nfvd4WJRvZ8yRFyGRUSXm")),
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.StakeProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"), IsSigner: false, IsWritable: true},
+					{PubKey: common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"), IsSigner: true, IsWritable: false},
+					{PubKey: common.PublicKeyFromString("DuNVVSmxNkXZvzBXQqNv8Q5nfvd4WJRvZ8yRFyGRUSXm"), IsSigner: true, IsWritable: false},
+				},
+				Data: []byte{12, 0, 0, 0, 1, 1, 0, 0, 0, 0, 0, 0, 0, 0},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SetLockupChecked(tt.args.param); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SetLockupChecked() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetMinimumDelegation(t *testing.T) {
+	want := types.Instruction{
+		ProgramID: common.StakeProgramID,
+		Accounts:  []types.AccountMeta{},
+		Data:      []byte{13, 0, 0, 0},
+	}
+	if got := GetMinimumDelegation(); !reflect.DeepEqual(got, want) {
+		t.Errorf("GetMinimumDelegation() = %v, want %v", got, want)
+	}
+}
+
+func TestDeactivateDelinquent(t *testing.T) {
+	type args struct {
+		param DeactivateDelinquentParam
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Instruction
+	}{
+		{
+			args: args{
+				param: DeactivateDelinquentParam{
+					Stake:          common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"),
+					DelinquentVote: common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"),
+					ReferenceVote:  common.PublicKeyFromString("DuNVVSmxNkXZvzBXQqNv8Q5nfvd4WJRvZ8yRFyGRUSXm"),
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.StakeProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"), IsSigner: false, IsWritable: true},
+					{PubKey: common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"), IsSigner: false, IsWritable: false},
+					{PubKey: common.PublicKeyFromString("DuNVVSmxNkXZvzBXQqNv8Q5nfvd4WJRvZ8yRFyGRUSXm"), IsSigner: false, IsWritable: false},
+				},
+				Data: []byte{14, 0, 0, 0},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DeactivateDelinquent(tt.args.param); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DeactivateDelinquent() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRedelegate(t *testing.T) {
+	type args struct {
+		param RedelegateParam
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Instruction
+	}{
+		{
+			args: args{
+				param: RedelegateParam{
+					Stake:    common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"),
+					NewStake: common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"),
+					Vote:     common.PublicKeyFromString("DuNVVSmxNkXZvzBXQqNv8Q5nfvd4WJRvZ8yRFyGRUSXm"),
+					Auth:     common.PublicKeyFromString("Gx6FKjrt1EbBKsA8DFSgkj6egv8R5AoATBk1j2J3GHxU"),
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.StakeProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"), IsSigner: false, IsWritable: true},
+					{PubKey: common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"), IsSigner: false, IsWritable: true},
+					{PubKey: common.PublicKeyFromString("DuNVVSmxNkXZvzBXQqNv8Q5nfvd4WJRvZ8yRFyGRUSXm"), IsSigner: false, IsWritable: false},
+					{PubKey: common.StakeConfigPubkey, IsSigner: false, IsWritable: false},
+					{PubKey: common.PublicKeyFromString("Gx6FKjrt1EbBKsA8DFSgkj6egv8R5AoATBk1j2J3GHxU"), IsSigner: true, IsWritable: false},
+				},
+				Data: []byte{15, 0, 0, 0},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Redelegate(tt.args.param); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Redelegate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMoveStake(t *testing.T) {
+	type args struct {
+		param MoveStakeParam
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Instruction
+	}{
+		{
+			args: args{
+				param: MoveStakeParam{
+					From:     common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"),
+					To:       common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"),
+					Auth:     common.PublicKeyFromString("DuNVVSmxNkXZvzBXQqNv8Q5nfvd4WJRvZ8yRFyGRUSXm"),
+					Lamports: 1000000000,
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.StakeProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"), IsSigner: false, IsWritable: true},
+					{PubKey: common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"), IsSigner: false, IsWritable: true},
+					{PubKey: common.PublicKeyFromString("DuNVVSmxNkXZvzBXQqNv8Q5nfvd4WJRvZ8yRFyGRUSXm"), IsSigner: true, IsWritable: false},
+				},
+				Data: []byte{16, 0, 0, 0, 0, 202, 154, 59, 0, 0, 0, 0},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MoveStake(tt.args.param); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MoveStake() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMoveLamports(t *testing.T) {
+	type args struct {
+		param MoveLamportsParam
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Instruction
+	}{
+		{
+			args: args{
+				param: MoveLamportsParam{
+					From:     common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"),
+					To:       common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"),
+					Auth:     common.PublicKeyFromString("DuNVVSmxNkXZvzBXQqNv8Q5nfvd4WJRvZ8yRFyGRUSXm"),
+					Lamports: 1,
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.StakeProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"), IsSigner: false, IsWritable: true},
+					{PubKey: common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"), IsSigner: false, IsWritable: true},
+					{PubKey: common.PublicKeyFromString("DuNVVSmxNkXZvzBXQqNv8Q5nfvd4WJRvZ8yRFyGRUSXm"), IsSigner: true, IsWritable: false},
+				},
+				Data: []byte{17, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MoveLamports(tt.args.param); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MoveLamports() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package stake
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/qimeila/solana-go-sdk/common"
+)
+
+type StakeStateType uint32
+
+const (
+	StakeStateUninitialized StakeStateType = iota
+	StakeStateInitialized
+	StakeStateStake
+	StakeStateRewardsPool
+)
+
+type StakeFlags uint8
+
+const (
+	StakeFlagsMustFullyActivateBeforeDeactivationIsPermitted StakeFlags = 1 << iota
+)
+
+type Meta struct {
+	RentExemptReserve uint64
+	Authorized        Authorized
+	Lockup            Lockup
+}
+
+type Delegation struct {
+	VoterPubkey       common.PublicKey
+	Stake             uint64
+	ActivationEpoch   uint64
+	DeactivationEpoch uint64
+	// Deprecated: the rate is decided by the cluster, see WarmupCooldownRate
+	WarmupCooldownRate float64
+}
+
+type Stake struct {
+	Delegation      Delegation
+	CreditsObserved uint64
+}
+
+// StakeStateV2 is the state of a stake account. Meta is set for Initialized and Stake, Stake is only set for Stake.
+type StakeStateV2 struct {
+	Type       StakeStateType
+	Meta       *Meta
+	Stake      *Stake
+	StakeFlags StakeFlags
+}
+
+func StakeStateV2FromData(data []byte) (StakeStateV2, error) {
+	if len(data) != int(AccountSize) {
+		return StakeStateV2{}, ErrInvalidAccountDataSize
+	}
+
+	current := 0
+	stateType := StakeStateType(binary.LittleEndian.Uint32(data[current : current+4]))
+	current += 4
+
+	switch stateType {
+	case StakeStateUninitialized, StakeStateRewardsPool:
+		return StakeStateV2{Type: stateType}, nil
+	case StakeStateInitialized, StakeStateStake:
+		meta := Meta{}
+		meta.RentExemptReserve = binary.LittleEndian.Uint64(data[current : current+8])
+		current += 8
+		meta.Authorized.Staker = common.PublicKeyFromBytes(data[current : current+32])
+		current += 32
+		meta.Authorized.Withdrawer = common.PublicKeyFromBytes(data[current : current+32])
+		current += 32
+		meta.Lockup.UnixTimestamp = int64(binary.LittleEndian.Uint64(data[current : current+8]))
+		current += 8
+		meta.Lockup.Epoch = binary.LittleEndian.Uint64(data[current : current+8])
+		current += 8
+		meta.Lockup.Cusodian = common.PublicKeyFromBytes(data[current : current+32])
+		current += 32
+
+		if stateType == StakeStateInitialized {
+			return StakeStateV2{Type: stateType, Meta: &meta}, nil
+		}
+
+		stake := Stake{}
+		stake.Delegation.VoterPubkey = common.PublicKeyFromBytes(data[current : current+32])
+		current += 32
+		stake.Delegation.Stake = binary.LittleEndian.Uint64(data[current : current+8])
+		current += 8
+		stake.Delegation.ActivationEpoch = binary.LittleEndian.Uint64(data[current : current+8])
+		current += 8
+		stake.Delegation.DeactivationEpoch = binary.LittleEndian.Uint64(data[current : current+8])
+		current += 8
+		stake.Delegation.WarmupCooldownRate = math.Float64frombits(binary.LittleEndian.Uint64(data[current : current+8]))
+		current += 8
+		stake.CreditsObserved = binary.LittleEndian.Uint64(data[current : current+8])
+		current += 8
+
+		return StakeStateV2{
+			Type:       stateType,
+			Meta:       &meta,
+			Stake:      &stake,
+			StakeFlags: StakeFlags(data[current]),
+		}, nil
+	}
+
+	return StakeStateV2{}, ErrInvalidAccountData
+}
+
+func DeserializeStakeStateV2(data []byte, accountOwner common.PublicKey) (StakeStateV2, error) {
+	if accountOwner != common.StakeProgramID {
+		return StakeStateV2{}, ErrInvalidAccountOwner
+	}
+	return StakeStateV2FromData(data)
+}
@@ -0,0 +1,125 @@
+package stake
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+
+	"github.com/qimeila/solana-go-sdk/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeserializeStakeStateV2(t *testing.T) {
+	staker := common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ")
+	withdrawer := common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7")
+	custodian := common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm")
+	voter := common.PublicKeyFromString("27kVX7JpPZ1bsrSckbR76mV6GeRqtrjoddubfg2zBpHZ")
+
+	newData := func(stateType StakeStateType) []byte {
+		data := make([]byte, AccountSize)
+		binary.LittleEndian.PutUint32(data[0:], uint32(stateType))
+		binary.LittleEndian.PutUint64(data[4:], 2282880)
+		copy(data[12:], staker.Bytes())
+		copy(data[44:], withdrawer.Bytes())
+		binary.LittleEndian.PutUint64(data[76:], uint64(1700000000))
+		binary.LittleEndian.PutUint64(data[84:], 500)
+		copy(data[92:], custodian.Bytes())
+		copy(data[124:], voter.Bytes())
+		binary.LittleEndian.PutUint64(data[156:], 1000000000)
+		binary.LittleEndian.PutUint64(data[164:], 400)
+		binary.LittleEndian.PutUint64(data[172:], math.MaxUint64)
+		binary.LittleEndian.PutUint64(data[180:], math.Float64bits(0.25))
+		binary.LittleEndian.PutUint64(data[188:], 123456)
+		data[196] = 1
+		return data
+	}
+	meta := Meta{
+		RentExemptReserve: 2282880,
+		Authorized:        Authorized{Staker: staker, Withdrawer: withdrawer},
+		Lockup:            Lockup{UnixTimestamp: 1700000000, Epoch: 500, Cusodian: custodian},
+	}
+
+	type args struct {
+		data         []byte
+		accountOwner common.PublicKey
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    StakeStateV2
+		wantErr error
+	}{
+		{
+			args: args{
+				data:         newData(StakeStateStake),
+				accountOwner: common.SystemProgramID,
+			},
+			want:    StakeStateV2{},
+			wantErr: ErrInvalidAccountOwner,
+		},
+		{
+			args: args{
+				data:         newData(StakeStateStake)[:197],
+				accountOwner: common.StakeProgramID,
+			},
+			want:    StakeStateV2{},
+			wantErr: ErrInvalidAccountDataSize,
+		},
+		{
+			args: args{
+				data:         make([]byte, AccountSize),
+				accountOwner: common.StakeProgramID,
+			},
+			want:    StakeStateV2{Type: StakeStateUninitialized},
+			wantErr: nil,
+		},
+		{
+			args: args{
+				data:         newData(StakeStateInitialized),
+				accountOwner: common.StakeProgramID,
+			},
+			want: StakeStateV2{
+				Type: StakeStateInitialized,
+				Meta: &meta,
+			},
+			wantErr: nil,
+		},
+		{
+			args: args{
+				data:         newData(StakeStateStake),
+				accountOwner: common.StakeProgramID,
+			},
+			want: StakeStateV2{
+				Type: StakeStateStake,
+				Meta: &meta,
+				Stake: &Stake{
+					Delegation: Delegation{
+						VoterPubkey:        voter,
+						Stake:              1000000000,
+						ActivationEpoch:    400,
+						DeactivationEpoch:  math.MaxUint64,
+						WarmupCooldownRate: 0.25,
+					},
+					CreditsObserved: 123456,
+				},
+				StakeFlags: StakeFlagsMustFullyActivateBeforeDeactivationIsPermitted,
+			},
+			wantErr: nil,
+		},
+		{
+			args: args{
+				data:         newData(4),
+				accountOwner: common.StakeProgramID,
+			},
+			want:    StakeStateV2{},
+			wantErr: ErrInvalidAccountData,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DeserializeStakeStateV2(tt.args.data, tt.args.accountOwner)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
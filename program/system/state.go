@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/qimeila/solana-go-sdk/common"
+	"github.com/qimeila/solana-go-sdk/pkg/bincode"
 )
 
 const FeeCalculatorSize = 8
@@ -37,19 +38,9 @@ func NonceAccountDeserialize(data []byte) (NonceAccount, error) {
 	if len(data) < NonceAccountSize {
 		return NonceAccount{}, fmt.Errorf("nonce account data size is not enough")
 	}
-	version := binary.LittleEndian.Uint32(data[:4])
-	state := binary.LittleEndian.Uint32(data[4:8])
-	authorizedPubkey := common.PublicKeyFromBytes(data[8:40])
-	nonce := common.PublicKeyFromBytes(data[40:72])
-	feeCalculator, err := FeeCalculatorDeserialize(data[72:])
-	if err != nil {
+	var nonceAccount NonceAccount
+	if err := bincode.DeserializeData(data, &nonceAccount); err != nil {
 		return NonceAccount{}, err
 	}
-	return NonceAccount{
-		Version:          version,
-		State:            state,
-		AuthorizedPubkey: authorizedPubkey,
-		Nonce:            nonce,
-		FeeCalculator:    feeCalculator,
-	}, nil
+	return nonceAccount, nil
 }
@@ -0,0 +1,33 @@
+package sysvar
+
+import (
+	"github.com/qimeila/solana-go-sdk/common"
+	"github.com/qimeila/solana-go-sdk/pkg/bincode"
+)
+
+const ClockSize = 40
+
+type Clock struct {
+	Slot uint64
+	// EpochStartTimestamp is the unix timestamp of the first slot in the epoch
+	EpochStartTimestamp int64
+	Epoch               uint64
+	// LeaderScheduleEpoch is the latest epoch whose leader schedule is known
+	LeaderScheduleEpoch uint64
+	UnixTimestamp       int64
+}
+
+func DeserializeClock(data []byte, owner common.PublicKey) (Clock, error) {
+	if owner != common.SysVarPubkey {
+		return Clock{}, ErrInvalidAccountOwner
+	}
+	if len(data) < ClockSize {
+		return Clock{}, ErrInvalidAccountDataSize
+	}
+
+	var clock Clock
+	if err := bincode.DeserializeData(data, &clock); err != nil {
+		return Clock{}, err
+	}
+	return clock, nil
+}
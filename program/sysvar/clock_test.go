@@ -0,0 +1,65 @@
+package sysvar
+
+import (
+	"testing"
+
+	"github.com/qimeila/solana-go-sdk/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeserializeClock(t *testing.T) {
+	type args struct {
+		data  []byte
+		owner common.PublicKey
+	}
+	tests := []struct {
+		name string
+		args args
+		want Clock
+		err  error
+	}{
+		{
+			args: args{
+				data:  []byte{},
+				owner: common.SystemProgramID,
+			},
+			want: Clock{},
+			err:  ErrInvalidAccountOwner,
+		},
+		{
+			args: args{
+				data:  []byte{1, 2, 3},
+				owner: common.SysVarPubkey,
+			},
+			want: Clock{},
+			err:  ErrInvalidAccountDataSize,
+		},
+		{
+			args: args{
+				data: []byte{
+					0xe0, 0x8c, 0xd2, 0x0c, 0, 0, 0, 0,
+					0x00, 0xf1, 0x53, 0x65, 0, 0, 0, 0,
+					0xf4, 0x01, 0, 0, 0, 0, 0, 0,
+					0xf5, 0x01, 0, 0, 0, 0, 0, 0,
+					0x10, 0xf5, 0x53, 0x65, 0, 0, 0, 0,
+				},
+				owner: common.SysVarPubkey,
+			},
+			want: Clock{
+				Slot:                215125216,
+				EpochStartTimestamp: 1700000000,
+				Epoch:               500,
+				LeaderScheduleEpoch: 501,
+				UnixTimestamp:       1700001040,
+			},
+			err: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DeserializeClock(tt.args.data, tt.args.owner)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package sysvar
+
+import (
+	"encoding/binary"
+	"math/big"
+
+	"github.com/qimeila/solana-go-sdk/common"
+)
+
+const EpochRewardsSize = 81
+
+// EpochRewards tracks the partitioned distribution of staking rewards at the start of an epoch
+type EpochRewards struct {
+	DistributionStartingBlockHeight uint64
+	NumPartitions                   uint64
+	ParentBlockhash                 [32]byte
+	// TotalPoints is an u128
+	TotalPoints        *big.Int
+	TotalRewards       uint64
+	DistributedRewards uint64
+	// Active is true while rewards are being distributed
+	Active bool
+}
+
+func DeserializeEpochRewards(data []byte, owner common.PublicKey) (EpochRewards, error) {
+	if owner != common.SysVarPubkey {
+		return EpochRewards{}, ErrInvalidAccountOwner
+	}
+	if len(data) < EpochRewardsSize {
+		return EpochRewards{}, ErrInvalidAccountDataSize
+	}
+
+	var parentBlockhash [32]byte
+	copy(parentBlockhash[:], data[16:48])
+
+	// u128 is little endian, big.Int wants big endian
+	totalPoints := make([]byte, 16)
+	for i := 0; i < 16; i++ {
+		totalPoints[i] = data[63-i]
+	}
+
+	return EpochRewards{
+		DistributionStartingBlockHeight: binary.LittleEndian.Uint64(data[0:8]),
+		NumPartitions:                   binary.LittleEndian.Uint64(data[8:16]),
+		ParentBlockhash:                 parentBlockhash,
+		TotalPoints:                     new(big.Int).SetBytes(totalPoints),
+		TotalRewards:                    binary.LittleEndian.Uint64(data[64:72]),
+		DistributedRewards:              binary.LittleEndian.Uint64(data[72:80]),
+		Active:                          data[80] == 1,
+	}, nil
+}
@@ -0,0 +1,51 @@
+package sysvar
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/qimeila/solana-go-sdk/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeserializeEpochRewards(t *testing.T) {
+	data := make([]byte, EpochRewardsSize)
+	data[0] = 0x40
+	data[1] = 0x42
+	data[2] = 0x0f
+	data[8] = 4
+	for i := 16; i < 48; i++ {
+		data[i] = byte(i)
+	}
+	// total points is 2^64 + 1
+	data[48] = 1
+	data[56] = 1
+	data[64] = 0xe8
+	data[65] = 0x03
+	data[72] = 0xf4
+	data[73] = 0x01
+	data[80] = 1
+
+	var parentBlockhash [32]byte
+	for i := range parentBlockhash {
+		parentBlockhash[i] = byte(i + 16)
+	}
+	totalPoints, _ := new(big.Int).SetString("18446744073709551617", 10)
+
+	got, err := DeserializeEpochRewards(data, common.SysVarPubkey)
+	assert.Nil(t, err)
+	assert.Equal(t, EpochRewards{
+		DistributionStartingBlockHeight: 1000000,
+		NumPartitions:                   4,
+		ParentBlockhash:                 parentBlockhash,
+		TotalPoints:                     totalPoints,
+		TotalRewards:                    1000,
+		DistributedRewards:              500,
+		Active:                          true,
+	}, got)
+
+	_, err = DeserializeEpochRewards(data, common.SystemProgramID)
+	assert.Equal(t, ErrInvalidAccountOwner, err)
+	_, err = DeserializeEpochRewards(data[:80], common.SysVarPubkey)
+	assert.Equal(t, ErrInvalidAccountDataSize, err)
+}
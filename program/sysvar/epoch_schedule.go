@@ -0,0 +1,78 @@
+package sysvar
+
+import (
+	"encoding/binary"
+	"math/bits"
+
+	"github.com/qimeila/solana-go-sdk/common"
+)
+
+const EpochScheduleSize = 33
+
+// MinimumSlotsPerEpoch is the length of the first epoch when warmup is enabled
+const MinimumSlotsPerEpoch uint64 = 32
+
+type EpochSchedule struct {
+	SlotsPerEpoch            uint64
+	LeaderScheduleSlotOffset uint64
+	// Warmup means epochs start at MinimumSlotsPerEpoch slots and double until they reach SlotsPerEpoch
+	Warmup           bool
+	FirstNormalEpoch uint64
+	FirstNormalSlot  uint64
+}
+
+// GetSlotsInEpoch returns the number of slots in epoch
+func (s EpochSchedule) GetSlotsInEpoch(epoch uint64) uint64 {
+	if epoch < s.FirstNormalEpoch {
+		return 1 << (epoch + uint64(bits.TrailingZeros64(MinimumSlotsPerEpoch)))
+	}
+	return s.SlotsPerEpoch
+}
+
+// GetEpochAndSlotIndex returns the epoch of slot and the slot's index in that epoch
+func (s EpochSchedule) GetEpochAndSlotIndex(slot uint64) (uint64, uint64) {
+	if slot < s.FirstNormalSlot {
+		epoch := uint64(bits.Len64(slot+MinimumSlotsPerEpoch)) - uint64(bits.TrailingZeros64(MinimumSlotsPerEpoch)) - 1
+		epochLen := uint64(1) << (epoch + uint64(bits.TrailingZeros64(MinimumSlotsPerEpoch)))
+		return epoch, slot - (epochLen - MinimumSlotsPerEpoch)
+	}
+
+	normalSlotIndex := slot - s.FirstNormalSlot
+	return s.FirstNormalEpoch + normalSlotIndex/s.SlotsPerEpoch, normalSlotIndex % s.SlotsPerEpoch
+}
+
+// GetEpoch returns the epoch of slot
+func (s EpochSchedule) GetEpoch(slot uint64) uint64 {
+	epoch, _ := s.GetEpochAndSlotIndex(slot)
+	return epoch
+}
+
+// GetFirstSlotInEpoch returns the first slot of epoch
+func (s EpochSchedule) GetFirstSlotInEpoch(epoch uint64) uint64 {
+	if epoch <= s.FirstNormalEpoch {
+		return ((uint64(1) << epoch) - 1) * MinimumSlotsPerEpoch
+	}
+	return (epoch-s.FirstNormalEpoch)*s.SlotsPerEpoch + s.FirstNormalSlot
+}
+
+// GetLastSlotInEpoch returns the last slot of epoch
+func (s EpochSchedule) GetLastSlotInEpoch(epoch uint64) uint64 {
+	return s.GetFirstSlotInEpoch(epoch) + s.GetSlotsInEpoch(epoch) - 1
+}
+
+func DeserializeEpochSchedule(data []byte, owner common.PublicKey) (EpochSchedule, error) {
+	if owner != common.SysVarPubkey {
+		return EpochSchedule{}, ErrInvalidAccountOwner
+	}
+	if len(data) < EpochScheduleSize {
+		return EpochSchedule{}, ErrInvalidAccountDataSize
+	}
+
+	return EpochSchedule{
+		SlotsPerEpoch:            binary.LittleEndian.Uint64(data[0:8]),
+		LeaderScheduleSlotOffset: binary.LittleEndian.Uint64(data[8:16]),
+		Warmup:                   data[16] == 1,
+		FirstNormalEpoch:         binary.LittleEndian.Uint64(data[17:25]),
+		FirstNormalSlot:          binary.LittleEndian.Uint64(data[25:33]),
+	}, nil
+}
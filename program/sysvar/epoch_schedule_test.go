@@ -0,0 +1,110 @@
+package sysvar
+
+import (
+	"testing"
+
+	"github.com/qimeila/solana-go-sdk/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeserializeEpochSchedule(t *testing.T) {
+	type args struct {
+		data  []byte
+		owner common.PublicKey
+	}
+	tests := []struct {
+		name string
+		args args
+		want EpochSchedule
+		err  error
+	}{
+		{
+			args: args{
+				data:  []byte{},
+				owner: common.SystemProgramID,
+			},
+			want: EpochSchedule{},
+			err:  ErrInvalidAccountOwner,
+		},
+		{
+			args: args{
+				data:  []byte{0x80, 0x97, 0x06, 0, 0, 0, 0, 0},
+				owner: common.SysVarPubkey,
+			},
+			want: EpochSchedule{},
+			err:  ErrInvalidAccountDataSize,
+		},
+		{
+			args: args{
+				data: []byte{
+					0x80, 0x97, 0x06, 0, 0, 0, 0, 0,
+					0x80, 0x97, 0x06, 0, 0, 0, 0, 0,
+					1,
+					0x0e, 0, 0, 0, 0, 0, 0, 0,
+					0xe0, 0xff, 0x07, 0, 0, 0, 0, 0,
+				},
+				owner: common.SysVarPubkey,
+			},
+			want: EpochSchedule{
+				SlotsPerEpoch:            432000,
+				LeaderScheduleSlotOffset: 432000,
+				Warmup:                   true,
+				FirstNormalEpoch:         14,
+				FirstNormalSlot:          524256,
+			},
+			err: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DeserializeEpochSchedule(tt.args.data, tt.args.owner)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestEpochSchedule(t *testing.T) {
+	// devnet and testnet start with warmup epochs
+	warmup := EpochSchedule{
+		SlotsPerEpoch:            432000,
+		LeaderScheduleSlotOffset: 432000,
+		Warmup:                   true,
+		FirstNormalEpoch:         14,
+		FirstNormalSlot:          524256,
+	}
+	noWarmup := EpochSchedule{
+		SlotsPerEpoch:            432000,
+		LeaderScheduleSlotOffset: 432000,
+	}
+
+	tests := []struct {
+		name          string
+		schedule      EpochSchedule
+		slot          uint64
+		wantEpoch     uint64
+		wantSlotIndex uint64
+	}{
+		{name: "first slot", schedule: warmup, slot: 0, wantEpoch: 0, wantSlotIndex: 0},
+		{name: "end of the first epoch", schedule: warmup, slot: 31, wantEpoch: 0, wantSlotIndex: 31},
+		{name: "second epoch", schedule: warmup, slot: 32, wantEpoch: 1, wantSlotIndex: 0},
+		{name: "third epoch", schedule: warmup, slot: 100, wantEpoch: 2, wantSlotIndex: 4},
+		{name: "last warmup slot", schedule: warmup, slot: 524255, wantEpoch: 13, wantSlotIndex: 262143},
+		{name: "first normal slot", schedule: warmup, slot: 524256, wantEpoch: 14, wantSlotIndex: 0},
+		{name: "normal epoch", schedule: warmup, slot: 524256 + 432000*2 + 5, wantEpoch: 16, wantSlotIndex: 5},
+		{name: "no warmup", schedule: noWarmup, slot: 214076640, wantEpoch: 495, wantSlotIndex: 236640},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			epoch, slotIndex := tt.schedule.GetEpochAndSlotIndex(tt.slot)
+			assert.Equal(t, tt.wantEpoch, epoch)
+			assert.Equal(t, tt.wantSlotIndex, slotIndex)
+			assert.Equal(t, tt.wantEpoch, tt.schedule.GetEpoch(tt.slot))
+
+			first := tt.schedule.GetFirstSlotInEpoch(epoch)
+			assert.Equal(t, tt.slot-tt.wantSlotIndex, first)
+			assert.Equal(t, first+tt.schedule.GetSlotsInEpoch(epoch)-1, tt.schedule.GetLastSlotInEpoch(epoch))
+			assert.Equal(t, epoch+1, tt.schedule.GetEpoch(tt.schedule.GetLastSlotInEpoch(epoch)+1))
+		})
+	}
+}
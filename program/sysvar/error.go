@@ -5,4 +5,7 @@ import "errors"
 var (
 	ErrInvalidAccountOwner    = errors.New("invalid account owner")
 	ErrInvalidAccountDataSize = errors.New("invalid account data size")
+	ErrInvalidAccountData     = errors.New("invalid account data")
+	ErrInstructionOutOfBounds = errors.New("instruction index out of bounds")
+	ErrLookupTableUnsupported = errors.New("messages with address lookup tables need the resolved addresses")
 )
@@ -0,0 +1,27 @@
+package sysvar
+
+import (
+	"github.com/qimeila/solana-go-sdk/common"
+	"github.com/qimeila/solana-go-sdk/program/system"
+)
+
+// Deprecated: the fees sysvar is no longer updated, use getFeeForMessage instead
+type Fees struct {
+	FeeCalculator system.FeeCalculator
+}
+
+// Deprecated: the fees sysvar is no longer updated, use getFeeForMessage instead
+func DeserializeFees(data []byte, owner common.PublicKey) (Fees, error) {
+	if owner != common.SysVarPubkey {
+		return Fees{}, ErrInvalidAccountOwner
+	}
+	if len(data) < system.FeeCalculatorSize {
+		return Fees{}, ErrInvalidAccountDataSize
+	}
+
+	feeCalculator, err := system.FeeCalculatorDeserialize(data)
+	if err != nil {
+		return Fees{}, err
+	}
+	return Fees{FeeCalculator: feeCalculator}, nil
+}
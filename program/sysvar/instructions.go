@@ -0,0 +1,199 @@
+package sysvar
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/qimeila/solana-go-sdk/common"
+	"github.com/qimeila/solana-go-sdk/types"
+)
+
+// flags of an account meta in the instructions sysvar
+const (
+	InstructionsAccountFlagSigner   uint8 = 1 << 0
+	InstructionsAccountFlagWritable uint8 = 1 << 1
+)
+
+// Instructions is the content of the instructions sysvar: every instruction of the transaction being
+// executed and the index of the one currently executing.
+type Instructions struct {
+	Instructions []types.Instruction
+	CurrentIndex uint16
+}
+
+// SerializeInstructions builds the instructions sysvar account data the runtime exposes to programs.
+// The layout is
+//
+//	u16 number of instructions
+//	u16 offset of each instruction from the start of the data
+//	each instruction: u16 number of accounts, (u8 flags, pubkey) per account, program id, u16 data length, data
+//	u16 current index
+func SerializeInstructions(instructions []types.Instruction, currentIndex uint16) ([]byte, error) {
+	if len(instructions) > math.MaxUint16 {
+		return nil, fmt.Errorf("too many instructions: %v", len(instructions))
+	}
+	if int(currentIndex) >= len(instructions) {
+		return nil, fmt.Errorf("%w, current index: %v, instructions: %v", ErrInstructionOutOfBounds, currentIndex, len(instructions))
+	}
+
+	data := make([]byte, 2+2*len(instructions))
+	binary.LittleEndian.PutUint16(data, uint16(len(instructions)))
+	for i, instruction := range instructions {
+		if len(instruction.Accounts) > math.MaxUint16 {
+			return nil, fmt.Errorf("too many accounts in instruction %v: %v", i, len(instruction.Accounts))
+		}
+		if len(instruction.Data) > math.MaxUint16 {
+			return nil, fmt.Errorf("data of instruction %v is too long: %v", i, len(instruction.Data))
+		}
+		if len(data) > math.MaxUint16 {
+			return nil, fmt.Errorf("instructions are too large to be indexed")
+		}
+		binary.LittleEndian.PutUint16(data[2+2*i:], uint16(len(data)))
+
+		data = binary.LittleEndian.AppendUint16(data, uint16(len(instruction.Accounts)))
+		for _, account := range instruction.Accounts {
+			var flags uint8
+			if account.IsSigner {
+				flags |= InstructionsAccountFlagSigner
+			}
+			if account.IsWritable {
+				flags |= InstructionsAccountFlagWritable
+			}
+			data = append(data, flags)
+			data = append(data, account.PubKey.Bytes()...)
+		}
+		data = append(data, instruction.ProgramID.Bytes()...)
+		data = binary.LittleEndian.AppendUint16(data, uint16(len(instruction.Data)))
+		data = append(data, instruction.Data...)
+	}
+	data = binary.LittleEndian.AppendUint16(data, currentIndex)
+
+	return data, nil
+}
+
+// SerializeInstructionsFromMessage is SerializeInstructions for the instructions of a message, it is
+// what a program sees when the message's instruction at currentIndex runs.
+func SerializeInstructionsFromMessage(message types.Message, currentIndex uint16) ([]byte, error) {
+	if len(message.AddressLookupTables) > 0 {
+		return nil, ErrLookupTableUnsupported
+	}
+	// without lookups a v0 message resolves its accounts like a legacy one
+	message.Version = types.MessageVersionLegacy
+	return SerializeInstructions(message.DecompileInstructions(), currentIndex)
+}
+
+func DeserializeInstructions(data []byte, owner common.PublicKey) (Instructions, error) {
+	if owner != common.SysVarPubkey {
+		return Instructions{}, ErrInvalidAccountOwner
+	}
+	if len(data) < 2 {
+		return Instructions{}, ErrInvalidAccountDataSize
+	}
+
+	n := binary.LittleEndian.Uint16(data)
+	instructions := make([]types.Instruction, 0, n)
+	for i := uint16(0); i < n; i++ {
+		instruction, err := LoadInstructionAt(data, i)
+		if err != nil {
+			return Instructions{}, err
+		}
+		instructions = append(instructions, instruction)
+	}
+	currentIndex, err := LoadCurrentIndex(data)
+	if err != nil {
+		return Instructions{}, err
+	}
+
+	return Instructions{
+		Instructions: instructions,
+		CurrentIndex: currentIndex,
+	}, nil
+}
+
+// LoadCurrentIndex reads the index of the executing instruction like the program side load_current_index_checked
+func LoadCurrentIndex(data []byte) (uint16, error) {
+	if len(data) < 2 {
+		return 0, ErrInvalidAccountDataSize
+	}
+	return binary.LittleEndian.Uint16(data[len(data)-2:]), nil
+}
+
+// LoadInstructionAt reads one instruction through the offsets table like the program side load_instruction_at_checked
+func LoadInstructionAt(data []byte, index uint16) (types.Instruction, error) {
+	if len(data) < 2 {
+		return types.Instruction{}, ErrInvalidAccountDataSize
+	}
+	n := binary.LittleEndian.Uint16(data)
+	if index >= n {
+		return types.Instruction{}, fmt.Errorf("%w, index: %v, instructions: %v", ErrInstructionOutOfBounds, index, n)
+	}
+	offsetAt := 2 + 2*int(index)
+	if len(data) < offsetAt+2 {
+		return types.Instruction{}, ErrInvalidAccountDataSize
+	}
+
+	current := int(binary.LittleEndian.Uint16(data[offsetAt:]))
+	next := func(size int) ([]byte, error) {
+		if len(data)-current < size {
+			return nil, ErrInvalidAccountDataSize
+		}
+		b := data[current : current+size]
+		current += size
+		return b, nil
+	}
+
+	b, err := next(2)
+	if err != nil {
+		return types.Instruction{}, err
+	}
+	numAccounts := binary.LittleEndian.Uint16(b)
+	accounts := make([]types.AccountMeta, 0, numAccounts)
+	for i := uint16(0); i < numAccounts; i++ {
+		b, err := next(33)
+		if err != nil {
+			return types.Instruction{}, err
+		}
+		accounts = append(accounts, types.AccountMeta{
+			PubKey:     common.PublicKeyFromBytes(b[1:]),
+			IsSigner:   b[0]&InstructionsAccountFlagSigner != 0,
+			IsWritable: b[0]&InstructionsAccountFlagWritable != 0,
+		})
+	}
+
+	b, err = next(32)
+	if err != nil {
+		return types.Instruction{}, err
+	}
+	programID := common.PublicKeyFromBytes(b)
+
+	b, err = next(2)
+	if err != nil {
+		return types.Instruction{}, err
+	}
+	b, err = next(int(binary.LittleEndian.Uint16(b)))
+	if err != nil {
+		return types.Instruction{}, err
+	}
+	instructionData := make([]byte, len(b))
+	copy(instructionData, b)
+
+	return types.Instruction{
+		ProgramID: programID,
+		Accounts:  accounts,
+		Data:      instructionData,
+	}, nil
+}
+
+// GetInstructionRelative reads the instruction at offset from the executing one like the program side get_instruction_relative
+func GetInstructionRelative(data []byte, offset int64) (types.Instruction, error) {
+	currentIndex, err := LoadCurrentIndex(data)
+	if err != nil {
+		return types.Instruction{}, err
+	}
+	index := int64(currentIndex) + offset
+	if index < 0 || index > math.MaxUint16 {
+		return types.Instruction{}, fmt.Errorf("%w, index: %v", ErrInstructionOutOfBounds, index)
+	}
+	return LoadInstructionAt(data, uint16(index))
+}
@@ -0,0 +1,110 @@
+package sysvar
+
+import (
+	"testing"
+
+	"github.com/qimeila/solana-go-sdk/common"
+	"github.com/qimeila/solana-go-sdk/program/memo"
+	"github.com/qimeila/solana-go-sdk/program/system"
+	"github.com/qimeila/solana-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSerializeInstructions(t *testing.T) {
+	signer := common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ")
+	program := common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm")
+
+	got, err := SerializeInstructions([]types.Instruction{
+		{
+			ProgramID: program,
+			Accounts:  []types.AccountMeta{{PubKey: signer, IsSigner: true, IsWritable: true}},
+			Data:      []byte{1, 2},
+		},
+	}, 0)
+	assert.Nil(t, err)
+
+	want := []byte{1, 0, 4, 0, 1, 0, 3}
+	want = append(want, signer.Bytes()...)
+	want = append(want, program.Bytes()...)
+	want = append(want, 2, 0, 1, 2, 0, 0)
+	assert.Equal(t, want, got)
+
+	_, err = SerializeInstructions([]types.Instruction{}, 0)
+	assert.ErrorIs(t, err, ErrInstructionOutOfBounds)
+}
+
+func TestSerializeInstructionsFromMessage(t *testing.T) {
+	feePayer := common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ")
+	to := common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm")
+
+	instructions := []types.Instruction{
+		system.Transfer(system.TransferParam{
+			From:   feePayer,
+			To:     to,
+			Amount: 1000000000,
+		}),
+		memo.BuildMemo(memo.BuildMemoParam{
+			SignerPubkeys: []common.PublicKey{feePayer},
+			Memo:          []byte("introspect me"),
+		}),
+	}
+	message := types.NewMessage(types.NewMessageParam{
+		FeePayer:        feePayer,
+		RecentBlockhash: "DjQ4csyDJ9ZQvNNbK838ATs5UrqMq8s4Pd5i1ts22HAQ",
+		Instructions:    instructions,
+	})
+
+	data, err := SerializeInstructionsFromMessage(message, 1)
+	assert.Nil(t, err)
+
+	got, err := DeserializeInstructions(data, common.SysVarPubkey)
+	assert.Nil(t, err)
+	assert.Equal(t, Instructions{
+		Instructions: []types.Instruction{
+			{
+				ProgramID: common.SystemProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: feePayer, IsSigner: true, IsWritable: true},
+					{PubKey: to, IsSigner: false, IsWritable: true},
+				},
+				Data: instructions[0].Data,
+			},
+			{
+				ProgramID: common.MemoProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: feePayer, IsSigner: true, IsWritable: true},
+				},
+				Data: []byte("introspect me"),
+			},
+		},
+		CurrentIndex: 1,
+	}, got)
+
+	// the way a program looks at the instruction before it
+	previous, err := GetInstructionRelative(data, -1)
+	assert.Nil(t, err)
+	assert.Equal(t, common.SystemProgramID, previous.ProgramID)
+	_, err = GetInstructionRelative(data, 1)
+	assert.ErrorIs(t, err, ErrInstructionOutOfBounds)
+	_, err = GetInstructionRelative(data, -2)
+	assert.ErrorIs(t, err, ErrInstructionOutOfBounds)
+
+	_, err = SerializeInstructionsFromMessage(types.Message{
+		Version:             types.MessageVersionV0,
+		AddressLookupTables: []types.CompiledAddressLookupTable{{AccountKey: to}},
+	}, 0)
+	assert.ErrorIs(t, err, ErrLookupTableUnsupported)
+}
+
+func TestDeserializeInstructions(t *testing.T) {
+	_, err := DeserializeInstructions([]byte{0, 0, 0, 0}, common.SystemProgramID)
+	assert.ErrorIs(t, err, ErrInvalidAccountOwner)
+
+	// the offset points past the end of the data
+	_, err = DeserializeInstructions([]byte{1, 0, 200, 0, 0, 0}, common.SysVarPubkey)
+	assert.ErrorIs(t, err, ErrInvalidAccountDataSize)
+
+	got, err := DeserializeInstructions([]byte{0, 0, 0, 0}, common.SysVarPubkey)
+	assert.Nil(t, err)
+	assert.Equal(t, Instructions{Instructions: []types.Instruction{}}, got)
+}
@@ -0,0 +1,27 @@
+package sysvar
+
+import (
+	"encoding/binary"
+
+	"github.com/qimeila/solana-go-sdk/common"
+)
+
+const LastRestartSlotSize = 8
+
+// LastRestartSlot is the slot of the last cluster restart, 0 if the cluster hasn't been restarted
+type LastRestartSlot struct {
+	LastRestartSlot uint64
+}
+
+func DeserializeLastRestartSlot(data []byte, owner common.PublicKey) (LastRestartSlot, error) {
+	if owner != common.SysVarPubkey {
+		return LastRestartSlot{}, ErrInvalidAccountOwner
+	}
+	if len(data) < LastRestartSlotSize {
+		return LastRestartSlot{}, ErrInvalidAccountDataSize
+	}
+
+	return LastRestartSlot{
+		LastRestartSlot: binary.LittleEndian.Uint64(data[0:8]),
+	}, nil
+}
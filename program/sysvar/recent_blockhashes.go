@@ -0,0 +1,28 @@
+package sysvar
+
+import (
+	"github.com/qimeila/solana-go-sdk/common"
+	"github.com/qimeila/solana-go-sdk/pkg/bincode"
+	"github.com/qimeila/solana-go-sdk/program/system"
+)
+
+type RecentBlockhashesEntry struct {
+	Blockhash     [32]byte
+	FeeCalculator system.FeeCalculator
+}
+
+// Deprecated: use getLatestBlockhash or the SlotHashes sysvar instead
+type RecentBlockhashes []RecentBlockhashesEntry
+
+// Deprecated: use getLatestBlockhash or the SlotHashes sysvar instead
+func DeserializeRecentBlockhashes(data []byte, owner common.PublicKey) (RecentBlockhashes, error) {
+	if owner != common.SysVarPubkey {
+		return RecentBlockhashes{}, ErrInvalidAccountOwner
+	}
+
+	var v RecentBlockhashes
+	if err := bincode.DeserializeData(data, &v); err != nil {
+		return RecentBlockhashes{}, err
+	}
+	return v, nil
+}
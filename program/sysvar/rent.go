@@ -0,0 +1,53 @@
+package sysvar
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/qimeila/solana-go-sdk/common"
+)
+
+// AccountStorageOverhead is the bytes every account is charged for on top of its data
+const AccountStorageOverhead uint64 = 128
+
+const RentSize = 17
+
+type Rent struct {
+	LamportsPerByteYear uint64
+	ExemptionThreshold  float64
+	BurnPercent         uint8
+}
+
+// DefaultRent is the rent configuration used by mainnet, devnet and testnet
+var DefaultRent = Rent{
+	LamportsPerByteYear: 3480,
+	ExemptionThreshold:  2.0,
+	BurnPercent:         50,
+}
+
+// MinimumBalance returns the lamports an account with dataLen bytes of data needs to be rent exempt.
+// It is the offline version of getMinimumBalanceForRentExemption.
+func (r Rent) MinimumBalance(dataLen uint64) uint64 {
+	bytes := AccountStorageOverhead + dataLen
+	return uint64(float64(bytes*r.LamportsPerByteYear) * r.ExemptionThreshold)
+}
+
+// IsExempt reports whether balance is enough for an account with dataLen bytes of data
+func (r Rent) IsExempt(balance uint64, dataLen uint64) bool {
+	return balance >= r.MinimumBalance(dataLen)
+}
+
+func DeserializeRent(data []byte, owner common.PublicKey) (Rent, error) {
+	if owner != common.SysVarPubkey {
+		return Rent{}, ErrInvalidAccountOwner
+	}
+	if len(data) < RentSize {
+		return Rent{}, ErrInvalidAccountDataSize
+	}
+
+	return Rent{
+		LamportsPerByteYear: binary.LittleEndian.Uint64(data[0:8]),
+		ExemptionThreshold:  math.Float64frombits(binary.LittleEndian.Uint64(data[8:16])),
+		BurnPercent:         data[16],
+	}, nil
+}
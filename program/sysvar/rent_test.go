@@ -0,0 +1,73 @@
+package sysvar
+
+import (
+	"testing"
+
+	"github.com/qimeila/solana-go-sdk/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeserializeRent(t *testing.T) {
+	type args struct {
+		data  []byte
+		owner common.PublicKey
+	}
+	tests := []struct {
+		name string
+		args args
+		want Rent
+		err  error
+	}{
+		{
+			args: args{
+				data:  []byte{},
+				owner: common.SystemProgramID,
+			},
+			want: Rent{},
+			err:  ErrInvalidAccountOwner,
+		},
+		{
+			args: args{
+				data:  []byte{152, 13, 0, 0, 0, 0, 0, 0},
+				owner: common.SysVarPubkey,
+			},
+			want: Rent{},
+			err:  ErrInvalidAccountDataSize,
+		},
+		{
+			args: args{
+				data:  []byte{152, 13, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 64, 50},
+				owner: common.SysVarPubkey,
+			},
+			want: DefaultRent,
+			err:  nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DeserializeRent(tt.args.data, tt.args.owner)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestRent_MinimumBalance(t *testing.T) {
+	tests := []struct {
+		name    string
+		dataLen uint64
+		want    uint64
+	}{
+		{dataLen: 0, want: 890880},
+		{dataLen: 82, want: 1461600},
+		{dataLen: 165, want: 2039280},
+		{dataLen: 200, want: 2282880},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, DefaultRent.MinimumBalance(tt.dataLen))
+		})
+	}
+	assert.True(t, DefaultRent.IsExempt(2039280, 165))
+	assert.False(t, DefaultRent.IsExempt(2039279, 165))
+}
@@ -2,7 +2,7 @@ package sysvar
 
 import (
 	"github.com/qimeila/solana-go-sdk/common"
-	"github.com/qimeila/solana-go-sdk/pkg/bytes_decoder"
+	"github.com/qimeila/solana-go-sdk/pkg/bincode"
 )
 
 type SlotHash struct {
@@ -17,24 +17,9 @@ func DeserializeSlotHashes(data []byte, owner common.PublicKey) (SlotHashes, err
 		return SlotHashes{}, ErrInvalidAccountOwner
 	}
 
-	current := 0
-	len, err := bytes_decoder.GetUint64(&current, data)
-	if err != nil {
+	var v SlotHashes
+	if err := bincode.DeserializeData(data, &v); err != nil {
 		return SlotHashes{}, err
 	}
-
-	v := make([]SlotHash, 0, len)
-	for i := uint64(0); i < len; i++ {
-		slot, err := bytes_decoder.GetUint64(&current, data)
-		if err != nil {
-			return SlotHashes{}, err
-		}
-		hash, err := bytes_decoder.GetBytes32(&current, data)
-		if err != nil {
-			return SlotHashes{}, err
-		}
-
-		v = append(v, SlotHash{Slot: slot, Hash: hash})
-	}
 	return v, nil
 }
@@ -0,0 +1,43 @@
+package sysvar
+
+import (
+	"sort"
+
+	"github.com/qimeila/solana-go-sdk/common"
+	"github.com/qimeila/solana-go-sdk/pkg/bincode"
+)
+
+type StakeHistoryEntry struct {
+	Effective    uint64
+	Activating   uint64
+	Deactivating uint64
+}
+
+type StakeHistoryItem struct {
+	Epoch uint64
+	StakeHistoryEntry
+}
+
+// StakeHistory is ordered by epoch from the newest to the oldest
+type StakeHistory []StakeHistoryItem
+
+// Get returns the cluster stake of an epoch, the second return value is false if the epoch is not in the history
+func (h StakeHistory) Get(epoch uint64) (StakeHistoryEntry, bool) {
+	i := sort.Search(len(h), func(i int) bool { return h[i].Epoch <= epoch })
+	if i < len(h) && h[i].Epoch == epoch {
+		return h[i].StakeHistoryEntry, true
+	}
+	return StakeHistoryEntry{}, false
+}
+
+func DeserializeStakeHistory(data []byte, owner common.PublicKey) (StakeHistory, error) {
+	if owner != common.SysVarPubkey {
+		return StakeHistory{}, ErrInvalidAccountOwner
+	}
+
+	var v StakeHistory
+	if err := bincode.DeserializeData(data, &v); err != nil {
+		return StakeHistory{}, err
+	}
+	return v, nil
+}
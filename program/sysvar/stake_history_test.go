@@ -0,0 +1,85 @@
+package sysvar
+
+import (
+	"testing"
+
+	"github.com/qimeila/solana-go-sdk/common"
+	"github.com/qimeila/solana-go-sdk/pkg/bincode"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeserializeStakeHistory(t *testing.T) {
+	type args struct {
+		data  []byte
+		owner common.PublicKey
+	}
+	tests := []struct {
+		name string
+		args args
+		want StakeHistory
+		err  error
+	}{
+		{
+			args: args{
+				data:  []byte{},
+				owner: common.SystemProgramID,
+			},
+			want: StakeHistory{},
+			err:  ErrInvalidAccountOwner,
+		},
+		{
+			args: args{
+				data: []byte{
+					2, 0, 0, 0, 0, 0, 0, 0,
+					11, 0, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0,
+					10, 0, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0,
+				},
+				owner: common.SysVarPubkey,
+			},
+			want: StakeHistory{
+				{Epoch: 11, StakeHistoryEntry: StakeHistoryEntry{Effective: 4, Activating: 3, Deactivating: 2}},
+				{Epoch: 10, StakeHistoryEntry: StakeHistoryEntry{Effective: 3, Activating: 2, Deactivating: 1}},
+			},
+			err: nil,
+		},
+		{
+			args: args{
+				data: []byte{
+					255, 255, 255, 255, 255, 255, 255, 255,
+					11, 0, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0,
+				},
+				owner: common.SysVarPubkey,
+			},
+			want: StakeHistory{},
+			err:  bincode.ErrUnexpectedEOF,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DeserializeStakeHistory(tt.args.data, tt.args.owner)
+			assert.Equal(t, tt.want, got)
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestStakeHistory_Get(t *testing.T) {
+	history := StakeHistory{
+		{Epoch: 12, StakeHistoryEntry: StakeHistoryEntry{Effective: 12}},
+		{Epoch: 11, StakeHistoryEntry: StakeHistoryEntry{Effective: 11}},
+		{Epoch: 9, StakeHistoryEntry: StakeHistoryEntry{Effective: 9}},
+	}
+
+	entry, ok := history.Get(11)
+	assert.True(t, ok)
+	assert.Equal(t, StakeHistoryEntry{Effective: 11}, entry)
+
+	entry, ok = history.Get(9)
+	assert.True(t, ok)
+	assert.Equal(t, StakeHistoryEntry{Effective: 9}, entry)
+
+	for _, epoch := range []uint64{8, 10, 13} {
+		_, ok = history.Get(epoch)
+		assert.False(t, ok)
+	}
+}
@@ -0,0 +1,69 @@
+package sysvar
+
+import (
+	"github.com/qimeila/solana-go-sdk/common"
+)
+
+// Sysvar is every sysvar which has a deserializer in this package
+type Sysvar interface {
+	Clock | EpochSchedule | EpochRewards | Fees | RecentBlockhashes | Rent | SlotHashes | StakeHistory | LastRestartSlot
+}
+
+// PubkeyOf returns the address of sysvar T
+func PubkeyOf[T Sysvar]() common.PublicKey {
+	var v T
+	switch any(v).(type) {
+	case Clock:
+		return common.SysVarClockPubkey
+	case EpochSchedule:
+		return common.SysVarEpochSchedulePubkey
+	case EpochRewards:
+		return common.SysVarEpochRewardsPubkey
+	case Fees:
+		return common.SysVarFeesPubkey
+	case RecentBlockhashes:
+		return common.SysVarRecentBlockhashsPubkey
+	case Rent:
+		return common.SysVarRentPubkey
+	case SlotHashes:
+		return common.SysVarSlotHashesPubkey
+	case StakeHistory:
+		return common.SysVarStakeHistoryPubkey
+	case LastRestartSlot:
+		return common.SysVarLastRestartSlotPubkey
+	}
+	panic("unreachable")
+}
+
+// Deserialize decodes the account data of sysvar T
+func Deserialize[T Sysvar](data []byte, owner common.PublicKey) (T, error) {
+	var (
+		v   T
+		out any
+		err error
+	)
+	switch any(v).(type) {
+	case Clock:
+		out, err = DeserializeClock(data, owner)
+	case EpochSchedule:
+		out, err = DeserializeEpochSchedule(data, owner)
+	case EpochRewards:
+		out, err = DeserializeEpochRewards(data, owner)
+	case Fees:
+		out, err = DeserializeFees(data, owner)
+	case RecentBlockhashes:
+		out, err = DeserializeRecentBlockhashes(data, owner)
+	case Rent:
+		out, err = DeserializeRent(data, owner)
+	case SlotHashes:
+		out, err = DeserializeSlotHashes(data, owner)
+	case StakeHistory:
+		out, err = DeserializeStakeHistory(data, owner)
+	case LastRestartSlot:
+		out, err = DeserializeLastRestartSlot(data, owner)
+	}
+	if err != nil {
+		return v, err
+	}
+	return out.(T), nil
+}
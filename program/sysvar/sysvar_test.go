@@ -0,0 +1,40 @@
+package sysvar
+
+import (
+	"testing"
+
+	"github.com/qimeila/solana-go-sdk/common"
+	"github.com/qimeila/solana-go-sdk/program/system"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPubkeyOf(t *testing.T) {
+	assert.Equal(t, common.SysVarClockPubkey, PubkeyOf[Clock]())
+	assert.Equal(t, common.SysVarEpochSchedulePubkey, PubkeyOf[EpochSchedule]())
+	assert.Equal(t, common.SysVarEpochRewardsPubkey, PubkeyOf[EpochRewards]())
+	assert.Equal(t, common.SysVarFeesPubkey, PubkeyOf[Fees]())
+	assert.Equal(t, common.SysVarRecentBlockhashsPubkey, PubkeyOf[RecentBlockhashes]())
+	assert.Equal(t, common.SysVarRentPubkey, PubkeyOf[Rent]())
+	assert.Equal(t, common.SysVarSlotHashesPubkey, PubkeyOf[SlotHashes]())
+	assert.Equal(t, common.SysVarStakeHistoryPubkey, PubkeyOf[StakeHistory]())
+	assert.Equal(t, common.SysVarLastRestartSlotPubkey, PubkeyOf[LastRestartSlot]())
+}
+
+func TestDeserialize(t *testing.T) {
+	fees, err := Deserialize[Fees]([]byte{0x88, 0x13, 0, 0, 0, 0, 0, 0}, common.SysVarPubkey)
+	assert.Nil(t, err)
+	assert.Equal(t, Fees{FeeCalculator: system.FeeCalculator{LamportsPerSignature: 5000}}, fees)
+
+	lastRestartSlot, err := Deserialize[LastRestartSlot]([]byte{0x40, 0x42, 0x0f, 0, 0, 0, 0, 0}, common.SysVarPubkey)
+	assert.Nil(t, err)
+	assert.Equal(t, LastRestartSlot{LastRestartSlot: 1000000}, lastRestartSlot)
+
+	rent, err := Deserialize[Rent]([]byte{152, 13, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 64, 50}, common.SysVarPubkey)
+	assert.Nil(t, err)
+	assert.Equal(t, DefaultRent, rent)
+
+	_, err = Deserialize[LastRestartSlot]([]byte{1}, common.SysVarPubkey)
+	assert.Equal(t, ErrInvalidAccountDataSize, err)
+	_, err = Deserialize[Clock](make([]byte, ClockSize), common.SystemProgramID)
+	assert.Equal(t, ErrInvalidAccountOwner, err)
+}
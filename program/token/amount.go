@@ -0,0 +1,214 @@
+package token
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"math/bits"
+	"strconv"
+	"strings"
+)
+
+// TokenAmount is a raw token amount together with its mint's decimals.
+// The ui amount is derived from both on demand, see String.
+type TokenAmount struct {
+	Amount   uint64
+	Decimals uint8
+}
+
+// NewTokenAmount wraps a raw amount
+func NewTokenAmount(amount uint64, decimals uint8) TokenAmount {
+	return TokenAmount{
+		Amount:   amount,
+		Decimals: decimals,
+	}
+}
+
+// ParseTokenAmount parses a ui amount like "1.234567" without going through floats.
+// It returns an error if the string has more fractional digits than decimals or doesn't fit in a u64.
+func ParseTokenAmount(s string, decimals uint8) (TokenAmount, error) {
+	intPart, fracPart, hasDot := strings.Cut(s, ".")
+	if intPart == "" && (!hasDot || fracPart == "") {
+		return TokenAmount{}, fmt.Errorf("%w, got: %q", ErrInvalidTokenAmount, s)
+	}
+	if !isDigits(intPart) || !isDigits(fracPart) {
+		return TokenAmount{}, fmt.Errorf("%w, got: %q", ErrInvalidTokenAmount, s)
+	}
+
+	fracPart = strings.TrimRight(fracPart, "0")
+	if len(fracPart) > int(decimals) {
+		return TokenAmount{}, fmt.Errorf("%w, %q has more than %v decimals", ErrInvalidTokenAmount, s, decimals)
+	}
+
+	// amount = int * 10^decimals + frac * 10^(decimals - len(frac)), any u8 decimals fits in a big.Int
+	amount, _ := new(big.Int).SetString(intPart+fracPart, 10)
+	if amount == nil {
+		amount = new(big.Int)
+	}
+	amount.Mul(amount, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(int(decimals)-len(fracPart))), nil))
+	if !amount.IsUint64() {
+		return TokenAmount{}, fmt.Errorf("%w, got: %q", ErrTokenAmountOverflow, s)
+	}
+
+	return NewTokenAmount(amount.Uint64(), decimals), nil
+}
+
+// MustParseTokenAmount is ParseTokenAmount but panics on error
+func MustParseTokenAmount(s string, decimals uint8) TokenAmount {
+	a, err := ParseTokenAmount(s, decimals)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// String returns the ui amount, e.g. "1.5" for 1500000 with 6 decimals
+func (a TokenAmount) String() string {
+	return formatUIAmount(a.Amount, a.Decimals)
+}
+
+func (a TokenAmount) IsZero() bool {
+	return a.Amount == 0
+}
+
+// CheckedAdd returns a + b. Both amounts must have the same decimals.
+func (a TokenAmount) CheckedAdd(b TokenAmount) (TokenAmount, error) {
+	if a.Decimals != b.Decimals {
+		return TokenAmount{}, ErrTokenAmountDecimalsMismatch
+	}
+	sum, carry := bits.Add64(a.Amount, b.Amount, 0)
+	if carry != 0 {
+		return TokenAmount{}, ErrTokenAmountOverflow
+	}
+	return NewTokenAmount(sum, a.Decimals), nil
+}
+
+// CheckedSub returns a - b. Both amounts must have the same decimals.
+func (a TokenAmount) CheckedSub(b TokenAmount) (TokenAmount, error) {
+	if a.Decimals != b.Decimals {
+		return TokenAmount{}, ErrTokenAmountDecimalsMismatch
+	}
+	diff, borrow := bits.Sub64(a.Amount, b.Amount, 0)
+	if borrow != 0 {
+		return TokenAmount{}, ErrTokenAmountUnderflow
+	}
+	return NewTokenAmount(diff, a.Decimals), nil
+}
+
+// CheckedMulDiv returns a * mul / div rounded down. The intermediate product is 128 bits so it doesn't overflow.
+func (a TokenAmount) CheckedMulDiv(mul, div uint64) (TokenAmount, error) {
+	if div == 0 {
+		return TokenAmount{}, ErrTokenAmountDivideByZero
+	}
+	hi, lo := bits.Mul64(a.Amount, mul)
+	if hi >= div {
+		return TokenAmount{}, ErrTokenAmountOverflow
+	}
+	quo, _ := bits.Div64(hi, lo, div)
+	return NewTokenAmount(quo, a.Decimals), nil
+}
+
+// Cmp compares the ui values of a and b and returns -1, 0 or +1.
+// Amounts with different decimals are compared by their value, not their raw amount.
+func (a TokenAmount) Cmp(b TokenAmount) int {
+	if a.Decimals == b.Decimals {
+		switch {
+		case a.Amount < b.Amount:
+			return -1
+		case a.Amount > b.Amount:
+			return 1
+		}
+		return 0
+	}
+
+	x := new(big.Int).SetUint64(a.Amount)
+	y := new(big.Int).SetUint64(b.Amount)
+	if a.Decimals < b.Decimals {
+		x.Mul(x, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(b.Decimals-a.Decimals)), nil))
+	} else {
+		y.Mul(y, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(a.Decimals-b.Decimals)), nil))
+	}
+	return x.Cmp(y)
+}
+
+func (a TokenAmount) Equal(b TokenAmount) bool {
+	return a.Cmp(b) == 0
+}
+
+func (a TokenAmount) LessThan(b TokenAmount) bool {
+	return a.Cmp(b) < 0
+}
+
+func (a TokenAmount) GreaterThan(b TokenAmount) bool {
+	return a.Cmp(b) > 0
+}
+
+type tokenAmountJSON struct {
+	Amount         string `json:"amount"`
+	Decimals       uint8  `json:"decimals"`
+	UIAmountString string `json:"uiAmountString"`
+}
+
+// MarshalJSON uses the same shape as the rpc token balance, amount is a string to keep u64 precision
+func (a TokenAmount) MarshalJSON() ([]byte, error) {
+	return json.Marshal(tokenAmountJSON{
+		Amount:         strconv.FormatUint(a.Amount, 10),
+		Decimals:       a.Decimals,
+		UIAmountString: a.String(),
+	})
+}
+
+func (a *TokenAmount) UnmarshalJSON(data []byte) error {
+	var v tokenAmountJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	if v.Amount == "" {
+		parsed, err := ParseTokenAmount(v.UIAmountString, v.Decimals)
+		if err != nil {
+			return err
+		}
+		*a = parsed
+		return nil
+	}
+
+	amount, err := strconv.ParseUint(v.Amount, 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w, got: %q", ErrInvalidTokenAmount, v.Amount)
+	}
+	*a = NewTokenAmount(amount, v.Decimals)
+	return nil
+}
+
+func formatUIAmount(amount uint64, decimals uint8) string {
+	s := strconv.FormatUint(amount, 10)
+	if decimals == 0 {
+		return s
+	}
+	if len(s) <= int(decimals) {
+		s = strings.Repeat("0", int(decimals)-len(s)+1) + s
+	}
+	intPart, fracPart := s[:len(s)-int(decimals)], strings.TrimRight(s[len(s)-int(decimals):], "0")
+	if fracPart == "" {
+		return intPart
+	}
+	return intPart + "." + fracPart
+}
+
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// amountAndDecimals picks the raw amount and decimals for checked instructions
+func amountAndDecimals(amount uint64, decimals uint8, tokenAmount *TokenAmount) (uint64, uint8) {
+	if tokenAmount != nil {
+		return tokenAmount.Amount, tokenAmount.Decimals
+	}
+	return amount, decimals
+}
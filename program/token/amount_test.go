@@ -0,0 +1,172 @@
+package token
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTokenAmount(t *testing.T) {
+	type args struct {
+		s        string
+		decimals uint8
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    TokenAmount
+		wantErr error
+	}{
+		{
+			args: args{s: "1.234567", decimals: 6},
+			want: TokenAmount{Amount: 1234567, Decimals: 6},
+		},
+		{
+			args: args{s: "9", decimals: 9},
+			want: TokenAmount{Amount: 9000000000, Decimals: 9},
+		},
+		{
+			args: args{s: "0.000000001", decimals: 9},
+			want: TokenAmount{Amount: 1, Decimals: 9},
+		},
+		{
+			args: args{s: ".50", decimals: 2},
+			want: TokenAmount{Amount: 50, Decimals: 2},
+		},
+		{
+			args: args{s: "1.2300", decimals: 2},
+			want: TokenAmount{Amount: 123, Decimals: 2},
+		},
+		{
+			args: args{s: "0", decimals: 0},
+			want: TokenAmount{Amount: 0, Decimals: 0},
+		},
+		{
+			args: args{s: "18446744073709551615", decimals: 0},
+			want: TokenAmount{Amount: math.MaxUint64, Decimals: 0},
+		},
+		{
+			args: args{s: "0.000000000000000000000000000001", decimals: 30},
+			want: TokenAmount{Amount: 1, Decimals: 30},
+		},
+		{
+			args: args{s: "0", decimals: 255},
+			want: TokenAmount{Amount: 0, Decimals: 255},
+		},
+		{
+			args:    args{s: "1", decimals: 20},
+			wantErr: ErrTokenAmountOverflow,
+		},
+		{
+			args:    args{s: "18446744073709551616", decimals: 0},
+			wantErr: ErrTokenAmountOverflow,
+		},
+		{
+			args:    args{s: "1.234", decimals: 2},
+			wantErr: ErrInvalidTokenAmount,
+		},
+		{
+			args:    args{s: "-1", decimals: 2},
+			wantErr: ErrInvalidTokenAmount,
+		},
+		{
+			args:    args{s: "1e9", decimals: 2},
+			wantErr: ErrInvalidTokenAmount,
+		},
+		{
+			args:    args{s: ".", decimals: 2},
+			wantErr: ErrInvalidTokenAmount,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseTokenAmount(tt.args.s, tt.args.decimals)
+			assert.True(t, errors.Is(err, tt.wantErr), "err: %v", err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTokenAmount_String(t *testing.T) {
+	tests := []struct {
+		name string
+		a    TokenAmount
+		want string
+	}{
+		{a: TokenAmount{Amount: 1500000, Decimals: 6}, want: "1.5"},
+		{a: TokenAmount{Amount: 1, Decimals: 3}, want: "0.001"},
+		{a: TokenAmount{Amount: 1000, Decimals: 3}, want: "1"},
+		{a: TokenAmount{Amount: 0, Decimals: 9}, want: "0"},
+		{a: TokenAmount{Amount: 42, Decimals: 0}, want: "42"},
+		{a: TokenAmount{Amount: 15, Decimals: 30}, want: "0.000000000000000000000000000015"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.a.String())
+		})
+	}
+}
+
+func TestTokenAmount_Checked(t *testing.T) {
+	a := NewTokenAmount(150, 2)
+	b := NewTokenAmount(50, 2)
+
+	sum, err := a.CheckedAdd(b)
+	assert.Nil(t, err)
+	assert.Equal(t, NewTokenAmount(200, 2), sum)
+
+	diff, err := a.CheckedSub(b)
+	assert.Nil(t, err)
+	assert.Equal(t, NewTokenAmount(100, 2), diff)
+
+	_, err = b.CheckedSub(a)
+	assert.Equal(t, ErrTokenAmountUnderflow, err)
+
+	_, err = NewTokenAmount(math.MaxUint64, 0).CheckedAdd(NewTokenAmount(1, 0))
+	assert.Equal(t, ErrTokenAmountOverflow, err)
+
+	_, err = a.CheckedAdd(NewTokenAmount(1, 3))
+	assert.Equal(t, ErrTokenAmountDecimalsMismatch, err)
+
+	// 1% of u64 max doesn't overflow in the intermediate product
+	got, err := NewTokenAmount(math.MaxUint64, 0).CheckedMulDiv(1, 100)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(math.MaxUint64/100), got.Amount)
+
+	got, err = NewTokenAmount(1000, 2).CheckedMulDiv(3, 7)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(428), got.Amount)
+
+	_, err = NewTokenAmount(math.MaxUint64, 0).CheckedMulDiv(2, 1)
+	assert.Equal(t, ErrTokenAmountOverflow, err)
+
+	_, err = a.CheckedMulDiv(1, 0)
+	assert.Equal(t, ErrTokenAmountDivideByZero, err)
+}
+
+func TestTokenAmount_Cmp(t *testing.T) {
+	assert.Equal(t, 0, NewTokenAmount(150, 2).Cmp(NewTokenAmount(1500, 3)))
+	assert.Equal(t, -1, NewTokenAmount(149, 2).Cmp(NewTokenAmount(1500, 3)))
+	assert.Equal(t, 1, NewTokenAmount(151, 2).Cmp(NewTokenAmount(150, 2)))
+	assert.True(t, NewTokenAmount(1, 9).LessThan(NewTokenAmount(1, 0)))
+	assert.True(t, NewTokenAmount(1, 0).GreaterThan(NewTokenAmount(1, 9)))
+	assert.True(t, NewTokenAmount(10, 1).Equal(NewTokenAmount(1, 0)))
+}
+
+func TestTokenAmount_JSON(t *testing.T) {
+	b, err := json.Marshal(NewTokenAmount(9000000000, 9))
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"amount":"9000000000","decimals":9,"uiAmountString":"9"}`, string(b))
+
+	var a TokenAmount
+	err = json.Unmarshal([]byte(`{"amount":"1234567","decimals":6,"uiAmount":1.234567,"uiAmountString":"1.234567"}`), &a)
+	assert.Nil(t, err)
+	assert.Equal(t, NewTokenAmount(1234567, 6), a)
+
+	err = json.Unmarshal([]byte(`{"decimals":6,"uiAmountString":"0.5"}`), &a)
+	assert.Nil(t, err)
+	assert.Equal(t, NewTokenAmount(500000, 6), a)
+}
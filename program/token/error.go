@@ -5,4 +5,15 @@ import "errors"
 var (
 	ErrInvalidAccountOwner    = errors.New("invalid account owner")
 	ErrInvalidAccountDataSize = errors.New("invalid account data size")
+
+	ErrInvalidTokenAmount          = errors.New("invalid token amount")
+	ErrTokenAmountOverflow         = errors.New("token amount overflow")
+	ErrTokenAmountUnderflow        = errors.New("token amount underflow")
+	ErrTokenAmountDivideByZero     = errors.New("token amount divide by zero")
+	ErrTokenAmountDecimalsMismatch = errors.New("token amount decimals mismatch")
+
+	ErrMultisigNotInitialized  = errors.New("multisig is not initialized")
+	ErrMultisigSignerNotMember = errors.New("signer is not a member of the multisig")
+	ErrMultisigDuplicateSigner = errors.New("duplicate multisig signer")
+	ErrMultisigThresholdNotMet = errors.New("multisig threshold not met")
 )
@@ -479,17 +479,22 @@ type TransferCheckedParam struct {
 	Signers  []common.PublicKey
 	Amount   uint64
 	Decimals uint8
+	// TokenAmount overrides Amount and Decimals if it is set
+	TokenAmount *TokenAmount
+	// ProgramID is common.TokenProgramID by default, use common.Token2022ProgramID for Token-2022 mints
+	ProgramID common.PublicKey
 }
 
 func TransferChecked(param TransferCheckedParam) types.Instruction {
+	amount, decimals := amountAndDecimals(param.Amount, param.Decimals, param.TokenAmount)
 	data, err := bincode.SerializeData(struct {
 		Instruction Instruction
 		Amount      uint64
 		Decimals    uint8
 	}{
 		Instruction: InstructionTransferChecked,
-		Amount:      param.Amount,
-		Decimals:    param.Decimals,
+		Amount:      amount,
+		Decimals:    decimals,
 	})
 	if err != nil {
 		panic(err)
@@ -505,7 +510,7 @@ func TransferChecked(param TransferCheckedParam) types.Instruction {
 	}
 
 	return types.Instruction{
-		ProgramID: common.TokenProgramID,
+		ProgramID: programIDOrDefault(param.ProgramID),
 		Accounts:  accounts,
 		Data:      data,
 	}
@@ -519,17 +524,22 @@ type ApproveCheckedParam struct {
 	Signers  []common.PublicKey
 	Amount   uint64
 	Decimals uint8
+	// TokenAmount overrides Amount and Decimals if it is set
+	TokenAmount *TokenAmount
+	// ProgramID is common.TokenProgramID by default, use common.Token2022ProgramID for Token-2022 mints
+	ProgramID common.PublicKey
 }
 
 func ApproveChecked(param ApproveCheckedParam) types.Instruction {
+	amount, decimals := amountAndDecimals(param.Amount, param.Decimals, param.TokenAmount)
 	data, err := bincode.SerializeData(struct {
 		Instruction Instruction
 		Amount      uint64
 		Decimals    uint8
 	}{
 		Instruction: InstructionApproveChecked,
-		Amount:      param.Amount,
-		Decimals:    param.Decimals,
+		Amount:      amount,
+		Decimals:    decimals,
 	})
 	if err != nil {
 		panic(err)
@@ -545,7 +555,7 @@ func ApproveChecked(param ApproveCheckedParam) types.Instruction {
 	}
 
 	return types.Instruction{
-		ProgramID: common.TokenProgramID,
+		ProgramID: programIDOrDefault(param.ProgramID),
 		Accounts:  accounts,
 		Data:      data,
 	}
@@ -558,17 +568,22 @@ type MintToCheckedParam struct {
 	To       common.PublicKey
 	Amount   uint64
 	Decimals uint8
+	// TokenAmount overrides Amount and Decimals if it is set
+	TokenAmount *TokenAmount
+	// ProgramID is common.TokenProgramID by default, use common.Token2022ProgramID for Token-2022 mints
+	ProgramID common.PublicKey
 }
 
 func MintToChecked(param MintToCheckedParam) types.Instruction {
+	amount, decimals := amountAndDecimals(param.Amount, param.Decimals, param.TokenAmount)
 	data, err := bincode.SerializeData(struct {
 		Instruction Instruction
 		Amount      uint64
 		Decimals    uint8
 	}{
 		Instruction: InstructionMintToChecked,
-		Amount:      param.Amount,
-		Decimals:    param.Decimals,
+		Amount:      amount,
+		Decimals:    decimals,
 	})
 	if err != nil {
 		panic(err)
@@ -585,7 +600,7 @@ func MintToChecked(param MintToCheckedParam) types.Instruction {
 	}
 
 	return types.Instruction{
-		ProgramID: common.TokenProgramID,
+		ProgramID: programIDOrDefault(param.ProgramID),
 		Accounts:  accounts,
 		Data:      data,
 	}
@@ -598,17 +613,22 @@ type BurnCheckedParam struct {
 	Mint     common.PublicKey
 	Amount   uint64
 	Decimals uint8
+	// TokenAmount overrides Amount and Decimals if it is set
+	TokenAmount *TokenAmount
+	// ProgramID is common.TokenProgramID by default, use common.Token2022ProgramID for Token-2022 mints
+	ProgramID common.PublicKey
 }
 
 func BurnChecked(param BurnCheckedParam) types.Instruction {
+	amount, decimals := amountAndDecimals(param.Amount, param.Decimals, param.TokenAmount)
 	data, err := bincode.SerializeData(struct {
 		Instruction Instruction
 		Amount      uint64
 		Decimals    uint8
 	}{
 		Instruction: InstructionBurnChecked,
-		Amount:      param.Amount,
-		Decimals:    param.Decimals,
+		Amount:      amount,
+		Decimals:    decimals,
 	})
 	if err != nil {
 		panic(err)
@@ -625,7 +645,7 @@ func BurnChecked(param BurnCheckedParam) types.Instruction {
 	}
 
 	return types.Instruction{
-		ProgramID: common.TokenProgramID,
+		ProgramID: programIDOrDefault(param.ProgramID),
 		Accounts:  accounts,
 		Data:      data,
 	}
@@ -792,3 +812,10 @@ func InitializeMint2(param InitializeMint2Param) types.Instruction {
 		Data: data,
 	}
 }
+
+func programIDOrDefault(programID common.PublicKey) common.PublicKey {
+	if programID == (common.PublicKey{}) {
+		return common.TokenProgramID
+	}
+	return programID
+}
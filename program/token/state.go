@@ -1,10 +1,10 @@
 package token
 
 import (
-	"bytes"
-	"encoding/binary"
+	"fmt"
 
 	"github.com/qimeila/solana-go-sdk/common"
+	"github.com/qimeila/solana-go-sdk/pkg/borsh"
 )
 
 var (
@@ -53,14 +53,50 @@ func MultisigAccountFromData(data []byte) (MultisigAccount, error) {
 	}, nil
 }
 
+// DeserializeMultisigAccount parses a multisig owned by the token program or Token-2022
+func DeserializeMultisigAccount(data []byte, accountOwner common.PublicKey) (MultisigAccount, error) {
+	if accountOwner != common.TokenProgramID && accountOwner != common.Token2022ProgramID {
+		return MultisigAccount{}, ErrInvalidAccountOwner
+	}
+	return MultisigAccountFromData(data)
+}
+
+// ValidateSigners checks the signers are distinct members of the multisig and there are at least M of them
+func (m MultisigAccount) ValidateSigners(signers []common.PublicKey) error {
+	if !m.IsInitialized {
+		return ErrMultisigNotInitialized
+	}
+
+	members := make(map[common.PublicKey]struct{}, len(m.Signers))
+	for _, member := range m.Signers {
+		members[member] = struct{}{}
+	}
+
+	seen := make(map[common.PublicKey]struct{}, len(signers))
+	for _, signer := range signers {
+		if _, ok := members[signer]; !ok {
+			return fmt.Errorf("%w, signer: %v", ErrMultisigSignerNotMember, signer)
+		}
+		if _, ok := seen[signer]; ok {
+			return fmt.Errorf("%w, signer: %v", ErrMultisigDuplicateSigner, signer)
+		}
+		seen[signer] = struct{}{}
+	}
+
+	if len(seen) < int(m.M) {
+		return fmt.Errorf("%w, required: %v of %v, got: %v", ErrMultisigThresholdNotMet, m.M, m.N, len(seen))
+	}
+	return nil
+}
+
 const MintAccountSize = 82
 
 type MintAccount struct {
-	MintAuthority   *common.PublicKey
+	MintAuthority   *common.PublicKey `borsh:"coption"`
 	Supply          uint64
 	Decimals        uint8
 	IsInitialized   bool
-	FreezeAuthority *common.PublicKey
+	FreezeAuthority *common.PublicKey `borsh:"coption"`
 }
 
 func MintAccountFromData(data []byte) (MintAccount, error) {
@@ -68,31 +104,26 @@ func MintAccountFromData(data []byte) (MintAccount, error) {
 		return MintAccount{}, ErrInvalidAccountDataSize
 	}
 
-	var mint *common.PublicKey
-	if bytes.Equal(data[:4], Some) {
-		key := common.PublicKeyFromBytes(data[4:36])
-		mint = &key
+	var mint MintAccount
+	if err := borsh.Deserialize(data, &mint); err != nil {
+		return MintAccount{}, err
 	}
+	return mint, nil
+}
 
-	supply := binary.LittleEndian.Uint64(data[36:44])
-
-	decimals := uint8(data[44])
-
-	isInitialized := data[45] == 1
-
-	var freezeAuthority *common.PublicKey
-	if bytes.Equal(data[46:50], Some) {
-		key := common.PublicKeyFromBytes(data[50:82])
-		freezeAuthority = &key
+// DeserializeMintAccount parses a mint owned by the token program or Token-2022.
+// Token-2022 mints keep the base layout and append their extensions after it.
+func DeserializeMintAccount(data []byte, accountOwner common.PublicKey) (MintAccount, error) {
+	switch accountOwner {
+	case common.TokenProgramID:
+		return MintAccountFromData(data)
+	case common.Token2022ProgramID:
+		if len(data) < MintAccountSize {
+			return MintAccount{}, ErrInvalidAccountDataSize
+		}
+		return MintAccountFromData(data[:MintAccountSize])
 	}
-
-	return MintAccount{
-		MintAuthority:   mint,
-		Supply:          supply,
-		Decimals:        decimals,
-		IsInitialized:   isInitialized,
-		FreezeAuthority: freezeAuthority,
-	}, nil
+	return MintAccount{}, ErrInvalidAccountOwner
 }
 
 const TokenAccountSize = 165
@@ -110,12 +141,12 @@ type TokenAccount struct {
 	Mint     common.PublicKey
 	Owner    common.PublicKey
 	Amount   uint64
-	Delegate *common.PublicKey
+	Delegate *common.PublicKey `borsh:"coption"`
 	State    TokenAccountState
 	// if is wrapped SOL, IsNative is the rent-exempt value
-	IsNative        *uint64
+	IsNative        *uint64 `borsh:"coption"`
 	DelegatedAmount uint64
-	CloseAuthority  *common.PublicKey
+	CloseAuthority  *common.PublicKey `borsh:"coption"`
 }
 
 func TokenAccountFromData(data []byte) (TokenAccount, error) {
@@ -123,44 +154,11 @@ func TokenAccountFromData(data []byte) (TokenAccount, error) {
 		return TokenAccount{}, ErrInvalidAccountDataSize
 	}
 
-	mint := common.PublicKeyFromBytes(data[:32])
-
-	owner := common.PublicKeyFromBytes(data[32:64])
-
-	amount := binary.LittleEndian.Uint64(data[64:72])
-
-	var delegate *common.PublicKey
-	if bytes.Equal(data[72:76], Some) {
-		key := common.PublicKeyFromBytes(data[76:108])
-		delegate = &key
-	}
-
-	state := TokenAccountState(data[108])
-
-	var isNative *uint64
-	if bytes.Equal(data[109:113], Some) {
-		num := binary.LittleEndian.Uint64(data[113:121])
-		isNative = &num
-	}
-
-	delegatedAmount := binary.LittleEndian.Uint64(data[121:129])
-
-	var closeAuthority *common.PublicKey
-	if bytes.Equal(data[129:133], Some) {
-		key := common.PublicKeyFromBytes(data[133:165])
-		closeAuthority = &key
+	var tokenAccount TokenAccount
+	if err := borsh.Deserialize(data, &tokenAccount); err != nil {
+		return TokenAccount{}, err
 	}
-
-	return TokenAccount{
-		Mint:            mint,
-		Owner:           owner,
-		Amount:          amount,
-		Delegate:        delegate,
-		State:           state,
-		IsNative:        isNative,
-		DelegatedAmount: delegatedAmount,
-		CloseAuthority:  closeAuthority,
-	}, nil
+	return tokenAccount, nil
 }
 
 func DeserializeTokenAccount(data []byte, accountOwner common.PublicKey) (TokenAccount, error) {
@@ -265,3 +265,125 @@ func TestDeserializeTokenAccount(t *testing.T) {
 		})
 	}
 }
+
+func TestDeserializeMintAccount(t *testing.T) {
+	mintData := make([]byte, MintAccountSize)
+	copy(mintData[:4], Some)
+	mintData[44] = 6
+	mintData[45] = 1
+
+	token2022Data := make([]byte, 170)
+	copy(token2022Data, mintData)
+	token2022Data[165] = 1
+
+	type args struct {
+		data         []byte
+		accountOwner common.PublicKey
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    MintAccount
+		wantErr error
+	}{
+		{
+			args: args{
+				data:         mintData,
+				accountOwner: common.SystemProgramID,
+			},
+			want:    MintAccount{},
+			wantErr: ErrInvalidAccountOwner,
+		},
+		{
+			args: args{
+				data:         mintData,
+				accountOwner: common.TokenProgramID,
+			},
+			want: MintAccount{
+				MintAuthority: &common.PublicKey{},
+				Decimals:      6,
+				IsInitialized: true,
+			},
+			wantErr: nil,
+		},
+		{
+			args: args{
+				data:         token2022Data,
+				accountOwner: common.TokenProgramID,
+			},
+			want:    MintAccount{},
+			wantErr: ErrInvalidAccountDataSize,
+		},
+		{
+			args: args{
+				data:         token2022Data,
+				accountOwner: common.Token2022ProgramID,
+			},
+			want: MintAccount{
+				MintAuthority: &common.PublicKey{},
+				Decimals:      6,
+				IsInitialized: true,
+			},
+			wantErr: nil,
+		},
+		{
+			args: args{
+				data:         mintData[:50],
+				accountOwner: common.Token2022ProgramID,
+			},
+			want:    MintAccount{},
+			wantErr: ErrInvalidAccountDataSize,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DeserializeMintAccount(tt.args.data, tt.args.accountOwner)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMultisigAccount_ValidateSigners(t *testing.T) {
+	signer1 := common.PublicKeyFromString("S1gner1111111111111111111111111111111111111")
+	signer2 := common.PublicKeyFromString("S1gner2111111111111111111111111111111111111")
+	multisig := MultisigAccount{
+		M:             1,
+		N:             2,
+		IsInitialized: true,
+		Signers:       []common.PublicKey{signer1, signer2},
+	}
+
+	tests := []struct {
+		name     string
+		multisig MultisigAccount
+		signers  []common.PublicKey
+		wantErr  error
+	}{
+		{
+			multisig: multisig,
+			signers:  []common.PublicKey{signer2},
+			wantErr:  nil,
+		},
+		{
+			multisig: multisig,
+			signers:  []common.PublicKey{},
+			wantErr:  ErrMultisigThresholdNotMet,
+		},
+		{
+			multisig: MultisigAccount{},
+			signers:  []common.PublicKey{signer1},
+			wantErr:  ErrMultisigNotInitialized,
+		},
+		{
+			multisig: multisig,
+			signers:  []common.PublicKey{common.PublicKeyFromString("S1gner3111111111111111111111111111111111111")},
+			wantErr:  ErrMultisigSignerNotMember,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, tt.multisig.ValidateSigners(tt.signers), tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,304 @@
+package vote
+
+import (
+	"github.com/qimeila/solana-go-sdk/common"
+	"github.com/qimeila/solana-go-sdk/pkg/bincode"
+	"github.com/qimeila/solana-go-sdk/types"
+)
+
+// AccountSize is the space a vote account needs
+const AccountSize uint64 = 3762
+
+type Instruction uint32
+
+const (
+	InstructionInitializeAccount Instruction = iota
+	InstructionAuthorize
+	InstructionVote
+	InstructionWithdraw
+	InstructionUpdateValidatorIdentity
+	InstructionUpdateCommission
+	InstructionVoteSwitch
+	InstructionAuthorizeChecked
+	InstructionUpdateVoteState
+	InstructionUpdateVoteStateSwitch
+	InstructionAuthorizeWithSeed
+	InstructionAuthorizeCheckedWithSeed
+	InstructionCompactUpdateVoteState
+	InstructionCompactUpdateVoteStateSwitch
+	InstructionTowerSync
+	InstructionTowerSyncSwitch
+)
+
+type VoteAuthorizationType uint32
+
+const (
+	VoteAuthorizationTypeVoter VoteAuthorizationType = iota
+	VoteAuthorizationTypeWithdrawer
+)
+
+type InitializeAccountParam struct {
+	Vote                 common.PublicKey
+	Node                 common.PublicKey
+	AuthorizedVoter      common.PublicKey
+	AuthorizedWithdrawer common.PublicKey
+	Commission           uint8
+}
+
+func InitializeAccount(param InitializeAccountParam) types.Instruction {
+	data, err := bincode.SerializeData(struct {
+		Instruction          Instruction
+		Node                 common.PublicKey
+		AuthorizedVoter      common.PublicKey
+		AuthorizedWithdrawer common.PublicKey
+		Commission           uint8
+	}{
+		Instruction:          InstructionInitializeAccount,
+		Node:                 param.Node,
+		AuthorizedVoter:      param.AuthorizedVoter,
+		AuthorizedWithdrawer: param.AuthorizedWithdrawer,
+		Commission:           param.Commission,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: common.VoteProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: param.Vote, IsSigner: false, IsWritable: true},
+			{PubKey: common.SysVarRentPubkey, IsSigner: false, IsWritable: false},
+			{PubKey: common.SysVarClockPubkey, IsSigner: false, IsWritable: false},
+			{PubKey: param.Node, IsSigner: true, IsWritable: false},
+		},
+		Data: data,
+	}
+}
+
+type AuthorizeParam struct {
+	Vote     common.PublicKey
+	Auth     common.PublicKey
+	NewAuth  common.PublicKey
+	AuthType VoteAuthorizationType
+}
+
+func Authorize(param AuthorizeParam) types.Instruction {
+	data, err := bincode.SerializeData(struct {
+		Instruction           Instruction
+		NewAuthorized         common.PublicKey
+		VoteAuthorizationType VoteAuthorizationType
+	}{
+		Instruction:           InstructionAuthorize,
+		NewAuthorized:         param.NewAuth,
+		VoteAuthorizationType: param.AuthType,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: common.VoteProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: param.Vote, IsSigner: false, IsWritable: true},
+			{PubKey: common.SysVarClockPubkey, IsSigner: false, IsWritable: false},
+			{PubKey: param.Auth, IsSigner: true, IsWritable: false},
+		},
+		Data: data,
+	}
+}
+
+type AuthorizeCheckedParam struct {
+	Vote     common.PublicKey
+	Auth     common.PublicKey
+	NewAuth  common.PublicKey
+	AuthType VoteAuthorizationType
+}
+
+// AuthorizeChecked is Authorize but the new authority has to sign
+func AuthorizeChecked(param AuthorizeCheckedParam) types.Instruction {
+	data, err := bincode.SerializeData(struct {
+		Instruction           Instruction
+		VoteAuthorizationType VoteAuthorizationType
+	}{
+		Instruction:           InstructionAuthorizeChecked,
+		VoteAuthorizationType: param.AuthType,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: common.VoteProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: param.Vote, IsSigner: false, IsWritable: true},
+			{PubKey: common.SysVarClockPubkey, IsSigner: false, IsWritable: false},
+			{PubKey: param.Auth, IsSigner: true, IsWritable: false},
+			{PubKey: param.NewAuth, IsSigner: true, IsWritable: false},
+		},
+		Data: data,
+	}
+}
+
+type AuthorizeWithSeedParam struct {
+	Vote      common.PublicKey
+	AuthBase  common.PublicKey
+	AuthSeed  string
+	AuthOwner common.PublicKey
+	NewAuth   common.PublicKey
+	AuthType  VoteAuthorizationType
+}
+
+// AuthorizeWithSeed changes an authority which is derived from AuthBase, AuthSeed and AuthOwner
+func AuthorizeWithSeed(param AuthorizeWithSeedParam) types.Instruction {
+	data, err := bincode.SerializeData(struct {
+		Instruction           Instruction
+		VoteAuthorizationType VoteAuthorizationType
+		AuthOwner             common.PublicKey
+		AuthSeed              string
+		NewAuth               common.PublicKey
+	}{
+		Instruction:           InstructionAuthorizeWithSeed,
+		VoteAuthorizationType: param.AuthType,
+		AuthOwner:             param.AuthOwner,
+		AuthSeed:              param.AuthSeed,
+		NewAuth:               param.NewAuth,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: common.VoteProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: param.Vote, IsSigner: false, IsWritable: true},
+			{PubKey: common.SysVarClockPubkey, IsSigner: false, IsWritable: false},
+			{PubKey: param.AuthBase, IsSigner: true, IsWritable: false},
+		},
+		Data: data,
+	}
+}
+
+type AuthorizeCheckedWithSeedParam struct {
+	Vote      common.PublicKey
+	AuthBase  common.PublicKey
+	AuthSeed  string
+	AuthOwner common.PublicKey
+	NewAuth   common.PublicKey
+	AuthType  VoteAuthorizationType
+}
+
+// AuthorizeCheckedWithSeed is AuthorizeWithSeed but the new authority has to sign
+func AuthorizeCheckedWithSeed(param AuthorizeCheckedWithSeedParam) types.Instruction {
+	data, err := bincode.SerializeData(struct {
+		Instruction           Instruction
+		VoteAuthorizationType VoteAuthorizationType
+		AuthOwner             common.PublicKey
+		AuthSeed              string
+	}{
+		Instruction:           InstructionAuthorizeCheckedWithSeed,
+		VoteAuthorizationType: param.AuthType,
+		AuthOwner:             param.AuthOwner,
+		AuthSeed:              param.AuthSeed,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: common.VoteProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: param.Vote, IsSigner: false, IsWritable: true},
+			{PubKey: common.SysVarClockPubkey, IsSigner: false, IsWritable: false},
+			{PubKey: param.AuthBase, IsSigner: true, IsWritable: false},
+			{PubKey: param.NewAuth, IsSigner: true, IsWritable: false},
+		},
+		Data: data,
+	}
+}
+
+type WithdrawParam struct {
+	Vote     common.PublicKey
+	Auth     common.PublicKey
+	To       common.PublicKey
+	Lamports uint64
+}
+
+func Withdraw(param WithdrawParam) types.Instruction {
+	data, err := bincode.SerializeData(struct {
+		Instruction Instruction
+		Lamports    uint64
+	}{
+		Instruction: InstructionWithdraw,
+		Lamports:    param.Lamports,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: common.VoteProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: param.Vote, IsSigner: false, IsWritable: true},
+			{PubKey: param.To, IsSigner: false, IsWritable: true},
+			{PubKey: param.Auth, IsSigner: true, IsWritable: false},
+		},
+		Data: data,
+	}
+}
+
+type UpdateValidatorIdentityParam struct {
+	Vote    common.PublicKey
+	NewNode common.PublicKey
+	// Auth is the withdraw authority
+	Auth common.PublicKey
+}
+
+func UpdateValidatorIdentity(param UpdateValidatorIdentityParam) types.Instruction {
+	data, err := bincode.SerializeData(struct {
+		Instruction Instruction
+	}{
+		Instruction: InstructionUpdateValidatorIdentity,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: common.VoteProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: param.Vote, IsSigner: false, IsWritable: true},
+			{PubKey: param.NewNode, IsSigner: true, IsWritable: false},
+			{PubKey: param.Auth, IsSigner: true, IsWritable: false},
+		},
+		Data: data,
+	}
+}
+
+type UpdateCommissionParam struct {
+	Vote common.PublicKey
+	// Auth is the withdraw authority
+	Auth       common.PublicKey
+	Commission uint8
+}
+
+func UpdateCommission(param UpdateCommissionParam) types.Instruction {
+	data, err := bincode.SerializeData(struct {
+		Instruction Instruction
+		Commission  uint8
+	}{
+		Instruction: InstructionUpdateCommission,
+		Commission:  param.Commission,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: common.VoteProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: param.Vote, IsSigner: false, IsWritable: true},
+			{PubKey: param.Auth, IsSigner: true, IsWritable: false},
+		},
+		Data: data,
+	}
+}
@@ -0,0 +1,278 @@
+package vote
+
+import (
+	"encoding/binary"
+
+	"github.com/qimeila/solana-go-sdk/common"
+)
+
+type VoteStateVersion uint32
+
+const (
+	VoteStateVersionV0_23_5 VoteStateVersion = iota
+	VoteStateVersionV1_14_11
+	VoteStateVersionCurrent
+)
+
+// MaxPriorVoters is the capacity of the prior voters ring buffer
+const MaxPriorVoters = 32
+
+type Lockout struct {
+	Slot              uint64
+	ConfirmationCount uint32
+}
+
+// LandedVote is a vote and its latency in slots, the latency is always 0 before VoteStateVersionCurrent
+type LandedVote struct {
+	Latency uint8
+	Lockout Lockout
+}
+
+type AuthorizedVoter struct {
+	Epoch  uint64
+	Pubkey common.PublicKey
+}
+
+type PriorVoter struct {
+	Pubkey     common.PublicKey
+	EpochStart uint64
+	EpochEnd   uint64
+}
+
+type EpochCredits struct {
+	Epoch       uint64
+	Credits     uint64
+	PrevCredits uint64
+}
+
+type BlockTimestamp struct {
+	Slot      uint64
+	Timestamp int64
+}
+
+// VoteState is the state of a vote account. Older versions are converted to the current layout.
+type VoteState struct {
+	Version              VoteStateVersion
+	NodePubkey           common.PublicKey
+	AuthorizedWithdrawer common.PublicKey
+	Commission           uint8
+	Votes                []LandedVote
+	RootSlot             *uint64
+	// AuthorizedVoters is ordered by epoch
+	AuthorizedVoters []AuthorizedVoter
+	// PriorVoters is ordered from the oldest to the newest
+	PriorVoters   []PriorVoter
+	EpochCredits  []EpochCredits
+	LastTimestamp BlockTimestamp
+}
+
+// AuthorizedVoter returns the voter which is authorized at epoch
+func (s VoteState) AuthorizedVoter(epoch uint64) (common.PublicKey, bool) {
+	for i := len(s.AuthorizedVoters) - 1; i >= 0; i-- {
+		if s.AuthorizedVoters[i].Epoch <= epoch {
+			return s.AuthorizedVoters[i].Pubkey, true
+		}
+	}
+	return common.PublicKey{}, false
+}
+
+// Credits returns the credits earned over the lifetime of the vote account
+func (s VoteState) Credits() uint64 {
+	if len(s.EpochCredits) == 0 {
+		return 0
+	}
+	return s.EpochCredits[len(s.EpochCredits)-1].Credits
+}
+
+func VoteStateFromData(data []byte) (VoteState, error) {
+	d := decoder{data: data}
+
+	state := VoteState{Version: VoteStateVersion(d.uint32())}
+	switch state.Version {
+	case VoteStateVersionV0_23_5:
+		state.NodePubkey = d.pubkey()
+		authorizedVoter := d.pubkey()
+		authorizedVoterEpoch := d.uint64()
+		state.AuthorizedVoters = []AuthorizedVoter{{Epoch: authorizedVoterEpoch, Pubkey: authorizedVoter}}
+
+		var buf [MaxPriorVoters]PriorVoter
+		for i := range buf {
+			buf[i].Pubkey = d.pubkey()
+			buf[i].EpochStart = d.uint64()
+			buf[i].EpochEnd = d.uint64()
+			d.uint64() // the slot the voter was replaced at
+		}
+		idx := d.uint64()
+		state.PriorVoters = priorVotersFromBuf(buf, idx)
+
+		state.AuthorizedWithdrawer = d.pubkey()
+		state.Commission = d.uint8()
+		state.Votes = d.lockouts()
+		state.RootSlot = d.optionUint64()
+	case VoteStateVersionV1_14_11, VoteStateVersionCurrent:
+		state.NodePubkey = d.pubkey()
+		state.AuthorizedWithdrawer = d.pubkey()
+		state.Commission = d.uint8()
+		if state.Version == VoteStateVersionV1_14_11 {
+			state.Votes = d.lockouts()
+		} else {
+			state.Votes = d.landedVotes()
+		}
+		state.RootSlot = d.optionUint64()
+
+		n := d.len(40)
+		state.AuthorizedVoters = make([]AuthorizedVoter, 0, n)
+		for i := 0; i < n; i++ {
+			state.AuthorizedVoters = append(state.AuthorizedVoters, AuthorizedVoter{Epoch: d.uint64(), Pubkey: d.pubkey()})
+		}
+
+		var buf [MaxPriorVoters]PriorVoter
+		for i := range buf {
+			buf[i].Pubkey = d.pubkey()
+			buf[i].EpochStart = d.uint64()
+			buf[i].EpochEnd = d.uint64()
+		}
+		idx := d.uint64()
+		if isEmpty := d.uint8(); isEmpty == 0 {
+			state.PriorVoters = priorVotersFromBuf(buf, idx)
+		} else {
+			state.PriorVoters = []PriorVoter{}
+		}
+	default:
+		return VoteState{}, ErrInvalidAccountData
+	}
+
+	n := d.len(24)
+	state.EpochCredits = make([]EpochCredits, 0, n)
+	for i := 0; i < n; i++ {
+		state.EpochCredits = append(state.EpochCredits, EpochCredits{Epoch: d.uint64(), Credits: d.uint64(), PrevCredits: d.uint64()})
+	}
+	state.LastTimestamp.Slot = d.uint64()
+	state.LastTimestamp.Timestamp = int64(d.uint64())
+
+	if d.err != nil {
+		return VoteState{}, d.err
+	}
+	return state, nil
+}
+
+func DeserializeVoteState(data []byte, accountOwner common.PublicKey) (VoteState, error) {
+	if accountOwner != common.VoteProgramID {
+		return VoteState{}, ErrInvalidAccountOwner
+	}
+	return VoteStateFromData(data)
+}
+
+// priorVotersFromBuf unrolls the ring buffer, idx points at the newest entry
+func priorVotersFromBuf(buf [MaxPriorVoters]PriorVoter, idx uint64) []PriorVoter {
+	voters := make([]PriorVoter, 0, MaxPriorVoters)
+	if idx >= MaxPriorVoters {
+		return voters
+	}
+	for i := uint64(1); i <= MaxPriorVoters; i++ {
+		v := buf[(idx+i)%MaxPriorVoters]
+		if v.Pubkey == (common.PublicKey{}) {
+			continue
+		}
+		voters = append(voters, v)
+	}
+	return voters
+}
+
+// decoder reads bincode values, the first failure sticks in err and later reads return zero values
+type decoder struct {
+	data    []byte
+	current int
+	err     error
+}
+
+func (d *decoder) next(n int) []byte {
+	if d.err != nil {
+		return nil
+	}
+	if len(d.data)-d.current < n {
+		d.err = ErrInvalidAccountDataSize
+		return nil
+	}
+	b := d.data[d.current : d.current+n]
+	d.current += n
+	return b
+}
+
+func (d *decoder) uint8() uint8 {
+	b := d.next(1)
+	if b == nil {
+		return 0
+	}
+	return b[0]
+}
+
+func (d *decoder) uint32() uint32 {
+	b := d.next(4)
+	if b == nil {
+		return 0
+	}
+	return binary.LittleEndian.Uint32(b)
+}
+
+func (d *decoder) uint64() uint64 {
+	b := d.next(8)
+	if b == nil {
+		return 0
+	}
+	return binary.LittleEndian.Uint64(b)
+}
+
+func (d *decoder) pubkey() common.PublicKey {
+	b := d.next(32)
+	if b == nil {
+		return common.PublicKey{}
+	}
+	return common.PublicKeyFromBytes(b)
+}
+
+func (d *decoder) optionUint64() *uint64 {
+	switch d.uint8() {
+	case 0:
+		return nil
+	case 1:
+		v := d.uint64()
+		return &v
+	default:
+		if d.err == nil {
+			d.err = ErrInvalidAccountData
+		}
+		return nil
+	}
+}
+
+// len reads a vec length and checks the remaining data can hold it
+func (d *decoder) len(itemSize int) int {
+	n := d.uint64()
+	if d.err != nil {
+		return 0
+	}
+	if n > uint64((len(d.data)-d.current)/itemSize) {
+		d.err = ErrInvalidAccountDataSize
+		return 0
+	}
+	return int(n)
+}
+
+func (d *decoder) lockouts() []LandedVote {
+	n := d.len(12)
+	votes := make([]LandedVote, 0, n)
+	for i := 0; i < n; i++ {
+		votes = append(votes, LandedVote{Lockout: Lockout{Slot: d.uint64(), ConfirmationCount: d.uint32()}})
+	}
+	return votes
+}
+
+func (d *decoder) landedVotes() []LandedVote {
+	n := d.len(13)
+	votes := make([]LandedVote, 0, n)
+	for i := 0; i < n; i++ {
+		votes = append(votes, LandedVote{Latency: d.uint8(), Lockout: Lockout{Slot: d.uint64(), ConfirmationCount: d.uint32()}})
+	}
+	return votes
+}
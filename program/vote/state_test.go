@@ -0,0 +1,254 @@
+package vote
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/qimeila/solana-go-sdk/common"
+	"github.com/qimeila/solana-go-sdk/pkg/pointer"
+	"github.com/stretchr/testify/assert"
+)
+
+type voteStateWriter struct {
+	data []byte
+}
+
+func (w *voteStateWriter) u8(v uint8) { w.data = append(w.data, v) }
+func (w *voteStateWriter) u32(v uint32) {
+	w.data = binary.LittleEndian.AppendUint32(w.data, v)
+}
+func (w *voteStateWriter) u64(v uint64) {
+	w.data = binary.LittleEndian.AppendUint64(w.data, v)
+}
+func (w *voteStateWriter) pubkey(v common.PublicKey) { w.data = append(w.data, v.Bytes()...) }
+
+func TestDeserializeVoteState(t *testing.T) {
+	node := common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ")
+	withdrawer := common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7")
+	voter := common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm")
+	priorVoter := common.PublicKeyFromString("27kVX7JpPZ1bsrSckbR76mV6GeRqtrjoddubfg2zBpHZ")
+
+	// the tail shared by every version: epoch credits and the last timestamp
+	writeTail := func(w *voteStateWriter) {
+		w.u64(2)
+		w.u64(500)
+		w.u64(1000)
+		w.u64(0)
+		w.u64(501)
+		w.u64(1500)
+		w.u64(1000)
+		w.u64(216000000)
+		w.u64(1700000000)
+	}
+	// prior voters with one entry written at index 0, the ring starts at MaxPriorVoters-1
+	writePriorVoters := func(w *voteStateWriter, withSlot bool) {
+		for i := 0; i < MaxPriorVoters; i++ {
+			if i == 0 {
+				w.pubkey(priorVoter)
+				w.u64(400)
+				w.u64(499)
+			} else {
+				w.pubkey(common.PublicKey{})
+				w.u64(0)
+				w.u64(0)
+			}
+			if withSlot {
+				w.u64(0)
+			}
+		}
+		w.u64(0)
+	}
+
+	newCurrent := func() []byte {
+		w := &voteStateWriter{}
+		w.u32(uint32(VoteStateVersionCurrent))
+		w.pubkey(node)
+		w.pubkey(withdrawer)
+		w.u8(10)
+		w.u64(2)
+		w.u8(1)
+		w.u64(215999998)
+		w.u32(2)
+		w.u8(0)
+		w.u64(215999999)
+		w.u32(1)
+		w.u8(1)
+		w.u64(215999990)
+		w.u64(1)
+		w.u64(500)
+		w.pubkey(voter)
+		writePriorVoters(w, false)
+		w.u8(0)
+		writeTail(w)
+		data := make([]byte, AccountSize)
+		copy(data, w.data)
+		return data
+	}
+	newV1_14_11 := func() []byte {
+		w := &voteStateWriter{}
+		w.u32(uint32(VoteStateVersionV1_14_11))
+		w.pubkey(node)
+		w.pubkey(withdrawer)
+		w.u8(10)
+		w.u64(1)
+		w.u64(215999999)
+		w.u32(1)
+		w.u8(0)
+		w.u64(1)
+		w.u64(500)
+		w.pubkey(voter)
+		writePriorVoters(w, false)
+		w.u8(1)
+		writeTail(w)
+		return w.data
+	}
+	newV0_23_5 := func() []byte {
+		w := &voteStateWriter{}
+		w.u32(uint32(VoteStateVersionV0_23_5))
+		w.pubkey(node)
+		w.pubkey(voter)
+		w.u64(500)
+		writePriorVoters(w, true)
+		w.pubkey(withdrawer)
+		w.u8(10)
+		w.u64(0)
+		w.u8(0)
+		writeTail(w)
+		return w.data
+	}
+
+	epochCredits := []EpochCredits{
+		{Epoch: 500, Credits: 1000, PrevCredits: 0},
+		{Epoch: 501, Credits: 1500, PrevCredits: 1000},
+	}
+	lastTimestamp := BlockTimestamp{Slot: 216000000, Timestamp: 1700000000}
+
+	type args struct {
+		data         []byte
+		accountOwner common.PublicKey
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    VoteState
+		wantErr error
+	}{
+		{
+			name: "invalid owner",
+			args: args{
+				data:         newCurrent(),
+				accountOwner: common.StakeProgramID,
+			},
+			want:    VoteState{},
+			wantErr: ErrInvalidAccountOwner,
+		},
+		{
+			name: "current",
+			args: args{
+				data:         newCurrent(),
+				accountOwner: common.VoteProgramID,
+			},
+			want: VoteState{
+				Version:              VoteStateVersionCurrent,
+				NodePubkey:           node,
+				AuthorizedWithdrawer: withdrawer,
+				Commission:           10,
+				Votes: []LandedVote{
+					{Latency: 1, Lockout: Lockout{Slot: 215999998, ConfirmationCount: 2}},
+					{Latency: 0, Lockout: Lockout{Slot: 215999999, ConfirmationCount: 1}},
+				},
+				RootSlot:         pointer.Get[uint64](215999990),
+				AuthorizedVoters: []AuthorizedVoter{{Epoch: 500, Pubkey: voter}},
+				PriorVoters:      []PriorVoter{{Pubkey: priorVoter, EpochStart: 400, EpochEnd: 499}},
+				EpochCredits:     epochCredits,
+				LastTimestamp:    lastTimestamp,
+			},
+		},
+		{
+			name: "1.14.11",
+			args: args{
+				data:         newV1_14_11(),
+				accountOwner: common.VoteProgramID,
+			},
+			want: VoteState{
+				Version:              VoteStateVersionV1_14_11,
+				NodePubkey:           node,
+				AuthorizedWithdrawer: withdrawer,
+				Commission:           10,
+				Votes: []LandedVote{
+					{Lockout: Lockout{Slot: 215999999, ConfirmationCount: 1}},
+				},
+				RootSlot:         nil,
+				AuthorizedVoters: []AuthorizedVoter{{Epoch: 500, Pubkey: voter}},
+				PriorVoters:      []PriorVoter{},
+				EpochCredits:     epochCredits,
+				LastTimestamp:    lastTimestamp,
+			},
+		},
+		{
+			name: "0.23.5",
+			args: args{
+				data:         newV0_23_5(),
+				accountOwner: common.VoteProgramID,
+			},
+			want: VoteState{
+				Version:              VoteStateVersionV0_23_5,
+				NodePubkey:           node,
+				AuthorizedWithdrawer: withdrawer,
+				Commission:           10,
+				Votes:                []LandedVote{},
+				RootSlot:             nil,
+				AuthorizedVoters:     []AuthorizedVoter{{Epoch: 500, Pubkey: voter}},
+				PriorVoters:          []PriorVoter{{Pubkey: priorVoter, EpochStart: 400, EpochEnd: 499}},
+				EpochCredits:         epochCredits,
+				LastTimestamp:        lastTimestamp,
+			},
+		},
+		{
+			name: "truncated",
+			args: args{
+				data:         newV1_14_11()[:100],
+				accountOwner: common.VoteProgramID,
+			},
+			want:    VoteState{},
+			wantErr: ErrInvalidAccountDataSize,
+		},
+		{
+			name: "unknown version",
+			args: args{
+				data:         []byte{9, 0, 0, 0},
+				accountOwner: common.VoteProgramID,
+			},
+			want:    VoteState{},
+			wantErr: ErrInvalidAccountData,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DeserializeVoteState(tt.args.data, tt.args.accountOwner)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestVoteState_AuthorizedVoter(t *testing.T) {
+	v1 := common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ")
+	v2 := common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7")
+	state := VoteState{
+		AuthorizedVoters: []AuthorizedVoter{{Epoch: 500, Pubkey: v1}, {Epoch: 502, Pubkey: v2}},
+		EpochCredits:     []EpochCredits{{Epoch: 500, Credits: 1000}, {Epoch: 501, Credits: 1500, PrevCredits: 1000}},
+	}
+
+	_, ok := state.AuthorizedVoter(499)
+	assert.False(t, ok)
+	got, ok := state.AuthorizedVoter(501)
+	assert.True(t, ok)
+	assert.Equal(t, v1, got)
+	got, ok = state.AuthorizedVoter(600)
+	assert.True(t, ok)
+	assert.Equal(t, v2, got)
+
+	assert.Equal(t, uint64(1500), state.Credits())
+	assert.Equal(t, uint64(0), VoteState{}.Credits())
+}
@@ -91,9 +91,12 @@ func (c *RpcClient) Call(ctx context.Context, params ...any) ([]byte, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to prepare payload, err: %v", err)
 	}
+	return c.post(ctx, j)
+}
 
+func (c *RpcClient) post(ctx context.Context, payload []byte) ([]byte, error) {
 	// prepare request
-	req, err := http.NewRequestWithContext(ctx, "POST", c.endpoint, bytes.NewBuffer(j))
+	req, err := http.NewRequestWithContext(ctx, "POST", c.endpoint, bytes.NewBuffer(payload))
 	if err != nil {
 		return nil, fmt.Errorf("failed to do http.NewRequestWithContext, err: %v", err)
 	}
@@ -151,3 +154,38 @@ func call[T any](c *RpcClient, ctx context.Context, params ...any) (T, error) {
 
 	return output, nil
 }
+
+// callWithNamedParams sends params as an object instead of an array, the das methods take their params by name
+func callWithNamedParams[T any](c *RpcClient, ctx context.Context, method string, params any) (T, error) {
+	var output T
+
+	// prepare payload
+	j, err := json.Marshal(struct {
+		JsonRpc string `json:"jsonrpc"`
+		Id      uint64 `json:"id"`
+		Method  string `json:"method"`
+		Params  any    `json:"params"`
+	}{
+		JsonRpc: "2.0",
+		Id:      1,
+		Method:  method,
+		Params:  params,
+	})
+	if err != nil {
+		return output, fmt.Errorf("rpc: failed to prepare payload, err: %v", err)
+	}
+
+	// rpc call
+	body, err := c.post(ctx, j)
+	if err != nil {
+		return output, fmt.Errorf("rpc: call error, err: %v, body: %v", err, string(body))
+	}
+
+	// transfer data
+	err = json.Unmarshal(body, &output)
+	if err != nil {
+		return output, fmt.Errorf("rpc: failed to json decode body, err: %v", err)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,58 @@
+package rpc
+
+// shared params and results of the digital asset standard (das) methods
+
+type DasSortBy string
+
+const (
+	DasSortByCreated      DasSortBy = "created"
+	DasSortByUpdated      DasSortBy = "updated"
+	DasSortByRecentAction DasSortBy = "recent_action"
+	DasSortByNone         DasSortBy = "none"
+)
+
+type DasSortDirection string
+
+const (
+	DasSortDirectionAsc  DasSortDirection = "asc"
+	DasSortDirectionDesc DasSortDirection = "desc"
+)
+
+type DasSorting struct {
+	SortBy        DasSortBy        `json:"sortBy"`
+	SortDirection DasSortDirection `json:"sortDirection,omitempty"`
+}
+
+// DasPagination selects a page. Use Page with Limit, or Cursor which is faster for large sets,
+// or Before/After to page by asset id.
+type DasPagination struct {
+	Page   int    `json:"page,omitempty"` // starts from 1
+	Limit  int    `json:"limit,omitempty"`
+	Cursor string `json:"cursor,omitempty"`
+	Before string `json:"before,omitempty"`
+	After  string `json:"after,omitempty"`
+}
+
+type DasDisplayOptions struct {
+	ShowUnverifiedCollections bool `json:"showUnverifiedCollections,omitempty"`
+	ShowCollectionMetadata    bool `json:"showCollectionMetadata,omitempty"`
+	ShowGrandTotal            bool `json:"showGrandTotal,omitempty"`
+	ShowFungible              bool `json:"showFungible,omitempty"`
+	ShowNativeBalance         bool `json:"showNativeBalance,omitempty"`
+	ShowZeroBalance           bool `json:"showZeroBalance,omitempty"`
+}
+
+// DasPage is a page of a das list method
+type DasPage[T any] struct {
+	Total  int    `json:"total"`
+	Limit  int    `json:"limit"`
+	Page   int    `json:"page,omitempty"`
+	Cursor string `json:"cursor,omitempty"`
+	Before string `json:"before,omitempty"`
+	After  string `json:"after,omitempty"`
+	// GrandTotal is only returned with the showGrandTotal option
+	GrandTotal *int `json:"grand_total,omitempty"`
+	Items      []T  `json:"items"`
+}
+
+type AssetList = DasPage[Asset]
@@ -0,0 +1,368 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/qimeila/solana-go-sdk/internal/client_test"
+)
+
+func TestGetAssetProof(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getAssetProof", "params":{"id":"Bu1DEKeawy7txbnCEJE4BU3BKLXaNAKCYcHR4XhndGss"}}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"root":"2o6Y6EiY3WXhoaEpei2pHmHLYnHDcEQVhgD89GrGHDBH","proof":["EmJXiXEAhEN3FfNQtBa5hwR8LC5kHvdLsaGCoERosZjK","7NEfhcNPAwbw3L87fjsPqTz2fQdd1CjoLE138SD58FDQ"],"node_index":16384,"leaf":"6YdZXw49M97mfFTwgQb6kxM2c6eqZkHSaW9XhhoZXtzv","tree_id":"2kuTFCcjbV22wvUmtmgsFR7cas7eZUzAu96jzJUvUcb7"},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewRpcClient(url)
+					return c.GetAssetProof(context.TODO(), "Bu1DEKeawy7txbnCEJE4BU3BKLXaNAKCYcHR4XhndGss")
+				},
+				ExpectedValue: JsonRpcResponse[AssetProof]{
+					JsonRpc: "2.0",
+					Id:      1,
+					Result: AssetProof{
+						Root:      "2o6Y6EiY3WXhoaEpei2pHmHLYnHDcEQVhgD89GrGHDBH",
+						Proof:     []string{"EmJXiXEAhEN3FfNQtBa5hwR8LC5kHvdLsaGCoERosZjK", "7NEfhcNPAwbw3L87fjsPqTz2fQdd1CjoLE138SD58FDQ"},
+						NodeIndex: 16384,
+						Leaf:      "6YdZXw49M97mfFTwgQb6kxM2c6eqZkHSaW9XhhoZXtzv",
+						TreeId:    "2kuTFCcjbV22wvUmtmgsFR7cas7eZUzAu96jzJUvUcb7",
+					},
+				},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
+
+func TestGetAssetBatch(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getAssetBatch", "params":{"ids":["F9Lw3ki3hJKeq4xjH7dPPBXsPbfXVhMsqaNgZqKhmpAS","Bu1DEKeawy7txbnCEJE4BU3BKLXaNAKCYcHR4XhndGss"]}}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":[{"interface":"V1_NFT","id":"F9Lw3ki3hJKeq4xjH7dPPBXsPbfXVhMsqaNgZqKhmpAS","ownership":{"delegated":false,"frozen":false,"owner":"86xCnPeV69n6t3DnyGvkKobf9FdN2H9oiVDdaMpo2MMY","ownership_model":"single"},"mutable":true,"burnt":false},null],"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewRpcClient(url)
+					return c.GetAssetBatch(context.TODO(), []string{"F9Lw3ki3hJKeq4xjH7dPPBXsPbfXVhMsqaNgZqKhmpAS", "Bu1DEKeawy7txbnCEJE4BU3BKLXaNAKCYcHR4XhndGss"})
+				},
+				ExpectedValue: JsonRpcResponse[[]*Asset]{
+					JsonRpc: "2.0",
+					Id:      1,
+					Result: []*Asset{
+						{
+							Interface: "V1_NFT",
+							Id:        "F9Lw3ki3hJKeq4xjH7dPPBXsPbfXVhMsqaNgZqKhmpAS",
+							Ownership: AssetOwnership{
+								Owner:          "86xCnPeV69n6t3DnyGvkKobf9FdN2H9oiVDdaMpo2MMY",
+								OwnershipModel: "single",
+							},
+							Mutable: true,
+						},
+						nil,
+					},
+				},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
+
+func TestGetAssetProofBatch(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getAssetProofBatch", "params":{"ids":["Bu1DEKeawy7txbnCEJE4BU3BKLXaNAKCYcHR4XhndGss"]}}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"Bu1DEKeawy7txbnCEJE4BU3BKLXaNAKCYcHR4XhndGss":{"root":"2o6Y6EiY3WXhoaEpei2pHmHLYnHDcEQVhgD89GrGHDBH","proof":[],"node_index":1,"leaf":"6YdZXw49M97mfFTwgQb6kxM2c6eqZkHSaW9XhhoZXtzv","tree_id":"2kuTFCcjbV22wvUmtmgsFR7cas7eZUzAu96jzJUvUcb7"}},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewRpcClient(url)
+					return c.GetAssetProofBatch(context.TODO(), []string{"Bu1DEKeawy7txbnCEJE4BU3BKLXaNAKCYcHR4XhndGss"})
+				},
+				ExpectedValue: JsonRpcResponse[map[string]*AssetProof]{
+					JsonRpc: "2.0",
+					Id:      1,
+					Result: map[string]*AssetProof{
+						"Bu1DEKeawy7txbnCEJE4BU3BKLXaNAKCYcHR4XhndGss": {
+							Root:      "2o6Y6EiY3WXhoaEpei2pHmHLYnHDcEQVhgD89GrGHDBH",
+							Proof:     []string{},
+							NodeIndex: 1,
+							Leaf:      "6YdZXw49M97mfFTwgQb6kxM2c6eqZkHSaW9XhhoZXtzv",
+							TreeId:    "2kuTFCcjbV22wvUmtmgsFR7cas7eZUzAu96jzJUvUcb7",
+						},
+					},
+				},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
+
+func TestGetAssetsByOwner(t *testing.T) {
+	grandTotal := 1
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getAssetsByOwner", "params":{"ownerAddress":"86xCnPeV69n6t3DnyGvkKobf9FdN2H9oiVDdaMpo2MMY"}}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"total":0,"limit":1000,"page":1,"items":[]},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewRpcClient(url)
+					return c.GetAssetsByOwner(context.TODO(), "86xCnPeV69n6t3DnyGvkKobf9FdN2H9oiVDdaMpo2MMY", GetAssetsByOwnerConfig{})
+				},
+				ExpectedValue: JsonRpcResponse[AssetList]{
+					JsonRpc: "2.0",
+					Id:      1,
+					Result: AssetList{
+						Total: 0,
+						Limit: 1000,
+						Page:  1,
+						Items: []Asset{},
+					},
+				},
+				ExpectedError: nil,
+			},
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getAssetsByOwner", "params":{"ownerAddress":"86xCnPeV69n6t3DnyGvkKobf9FdN2H9oiVDdaMpo2MMY","sortBy":{"sortBy":"created","sortDirection":"asc"},"page":2,"limit":1,"options":{"showGrandTotal":true}}}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"total":1,"limit":1,"page":2,"grand_total":1,"items":[{"interface":"V1_NFT","id":"F9Lw3ki3hJKeq4xjH7dPPBXsPbfXVhMsqaNgZqKhmpAS","ownership":{"delegated":false,"frozen":false,"owner":"86xCnPeV69n6t3DnyGvkKobf9FdN2H9oiVDdaMpo2MMY","ownership_model":"single"},"mutable":true,"burnt":false}]},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewRpcClient(url)
+					return c.GetAssetsByOwner(
+						context.TODO(),
+						"86xCnPeV69n6t3DnyGvkKobf9FdN2H9oiVDdaMpo2MMY",
+						GetAssetsByOwnerConfig{
+							SortBy:        &DasSorting{SortBy: DasSortByCreated, SortDirection: DasSortDirectionAsc},
+							DasPagination: DasPagination{Page: 2, Limit: 1},
+							Options:       &DasDisplayOptions{ShowGrandTotal: true},
+						},
+					)
+				},
+				ExpectedValue: JsonRpcResponse[AssetList]{
+					JsonRpc: "2.0",
+					Id:      1,
+					Result: AssetList{
+						Total:      1,
+						Limit:      1,
+						Page:       2,
+						GrandTotal: &grandTotal,
+						Items: []Asset{
+							{
+								Interface: "V1_NFT",
+								Id:        "F9Lw3ki3hJKeq4xjH7dPPBXsPbfXVhMsqaNgZqKhmpAS",
+								Ownership: AssetOwnership{
+									Owner:          "86xCnPeV69n6t3DnyGvkKobf9FdN2H9oiVDdaMpo2MMY",
+									OwnershipModel: "single",
+								},
+								Mutable: true,
+							},
+						},
+					},
+				},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
+
+func TestGetAssetsByGroup(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getAssetsByGroup", "params":{"groupKey":"collection","groupValue":"J1S9H3QjnRtBbbuD4HjPV6RpRhwuk4zKbxsnCHuTgh9w","cursor":"2kuTFCcjbV22wvUmtmgsFR7cas7eZUzAu96jzJUvUcb7","limit":10}}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"total":0,"limit":10,"cursor":"2kuTFCcjbV22wvUmtmgsFR7cas7eZUzAu96jzJUvUcb7","items":[]},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewRpcClient(url)
+					return c.GetAssetsByGroup(
+						context.TODO(),
+						"collection",
+						"J1S9H3QjnRtBbbuD4HjPV6RpRhwuk4zKbxsnCHuTgh9w",
+						GetAssetsByGroupConfig{
+							DasPagination: DasPagination{Cursor: "2kuTFCcjbV22wvUmtmgsFR7cas7eZUzAu96jzJUvUcb7", Limit: 10},
+						},
+					)
+				},
+				ExpectedValue: JsonRpcResponse[AssetList]{
+					JsonRpc: "2.0",
+					Id:      1,
+					Result: AssetList{
+						Limit:  10,
+						Cursor: "2kuTFCcjbV22wvUmtmgsFR7cas7eZUzAu96jzJUvUcb7",
+						Items:  []Asset{},
+					},
+				},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
+
+func TestGetAssetsByCreator(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getAssetsByCreator", "params":{"creatorAddress":"D3XrkNZz6wx6cofot7Zohsf2KSsu2ArngNk8VqU9cTY3","onlyVerified":true,"page":1}}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"total":0,"limit":1000,"page":1,"items":[]},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewRpcClient(url)
+					return c.GetAssetsByCreator(
+						context.TODO(),
+						"D3XrkNZz6wx6cofot7Zohsf2KSsu2ArngNk8VqU9cTY3",
+						GetAssetsByCreatorConfig{
+							OnlyVerified:  true,
+							DasPagination: DasPagination{Page: 1},
+						},
+					)
+				},
+				ExpectedValue: JsonRpcResponse[AssetList]{
+					JsonRpc: "2.0",
+					Id:      1,
+					Result:  AssetList{Limit: 1000, Page: 1, Items: []Asset{}},
+				},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
+
+func TestGetAssetsByAuthority(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getAssetsByAuthority", "params":{"authorityAddress":"2RtGg6fsFiiF1EQzHqbd66AhW7R5bWeQGpTbv2UMkCdW","before":"F9Lw3ki3hJKeq4xjH7dPPBXsPbfXVhMsqaNgZqKhmpAS","after":"Bu1DEKeawy7txbnCEJE4BU3BKLXaNAKCYcHR4XhndGss"}}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"total":0,"limit":1000,"before":"F9Lw3ki3hJKeq4xjH7dPPBXsPbfXVhMsqaNgZqKhmpAS","after":"Bu1DEKeawy7txbnCEJE4BU3BKLXaNAKCYcHR4XhndGss","items":[]},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewRpcClient(url)
+					return c.GetAssetsByAuthority(
+						context.TODO(),
+						"2RtGg6fsFiiF1EQzHqbd66AhW7R5bWeQGpTbv2UMkCdW",
+						GetAssetsByAuthorityConfig{
+							DasPagination: DasPagination{
+								Before: "F9Lw3ki3hJKeq4xjH7dPPBXsPbfXVhMsqaNgZqKhmpAS",
+								After:  "Bu1DEKeawy7txbnCEJE4BU3BKLXaNAKCYcHR4XhndGss",
+							},
+						},
+					)
+				},
+				ExpectedValue: JsonRpcResponse[AssetList]{
+					JsonRpc: "2.0",
+					Id:      1,
+					Result: AssetList{
+						Limit:  1000,
+						Before: "F9Lw3ki3hJKeq4xjH7dPPBXsPbfXVhMsqaNgZqKhmpAS",
+						After:  "Bu1DEKeawy7txbnCEJE4BU3BKLXaNAKCYcHR4XhndGss",
+						Items:  []Asset{},
+					},
+				},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
+
+func TestSearchAssets(t *testing.T) {
+	compressed := true
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"searchAssets", "params":{"ownerAddress":"86xCnPeV69n6t3DnyGvkKobf9FdN2H9oiVDdaMpo2MMY","grouping":["collection","J1S9H3QjnRtBbbuD4HjPV6RpRhwuk4zKbxsnCHuTgh9w"],"compressed":true,"page":1,"limit":100}}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"total":0,"limit":100,"page":1,"items":[]},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewRpcClient(url)
+					return c.SearchAssets(
+						context.TODO(),
+						SearchAssetsConfig{
+							OwnerAddress:  "86xCnPeV69n6t3DnyGvkKobf9FdN2H9oiVDdaMpo2MMY",
+							Grouping:      []string{"collection", "J1S9H3QjnRtBbbuD4HjPV6RpRhwuk4zKbxsnCHuTgh9w"},
+							Compressed:    &compressed,
+							DasPagination: DasPagination{Page: 1, Limit: 100},
+						},
+					)
+				},
+				ExpectedValue: JsonRpcResponse[AssetList]{
+					JsonRpc: "2.0",
+					Id:      1,
+					Result:  AssetList{Limit: 100, Page: 1, Items: []Asset{}},
+				},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
+
+func TestGetSignaturesForAsset(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getSignaturesForAsset", "params":{"id":"FNt6A9Mfnqbwc1tY7uwAguKQ1JcpBrxmhczDgbdJy5AC","page":1,"limit":2}}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"total":2,"limit":2,"page":1,"items":[["5nLi8m72bU6PBcz4Xrk23P6KTGy9ufF92kZiQXjTv9ELgkUxrNaiCGhMF4vh6RAcisw9DEQWJt9ogM3G2uCuwwV7","MintToCollectionV1"],["323Ag4J69gagBt3neUvajNauMydiXZTmXYSfdK5swWcK1iwCUypcXv45UFcy5PTt136G9gtQ45oyPJRs1f2zFZ3v","Transfer"]]},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewRpcClient(url)
+					return c.GetSignaturesForAsset(
+						context.TODO(),
+						"FNt6A9Mfnqbwc1tY7uwAguKQ1JcpBrxmhczDgbdJy5AC",
+						GetSignaturesForAssetConfig{DasPagination: DasPagination{Page: 1, Limit: 2}},
+					)
+				},
+				ExpectedValue: JsonRpcResponse[DasPage[AssetSignature]]{
+					JsonRpc: "2.0",
+					Id:      1,
+					Result: DasPage[AssetSignature]{
+						Total: 2,
+						Limit: 2,
+						Page:  1,
+						Items: []AssetSignature{
+							{Signature: "5nLi8m72bU6PBcz4Xrk23P6KTGy9ufF92kZiQXjTv9ELgkUxrNaiCGhMF4vh6RAcisw9DEQWJt9ogM3G2uCuwwV7", Type: "MintToCollectionV1"},
+							{Signature: "323Ag4J69gagBt3neUvajNauMydiXZTmXYSfdK5swWcK1iwCUypcXv45UFcy5PTt136G9gtQ45oyPJRs1f2zFZ3v", Type: "Transfer"},
+						},
+					},
+				},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
+
+func TestGetTokenAccounts(t *testing.T) {
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getTokenAccounts", "params":{"mint":"DezXAZ8z7PnrnRJjz3wXBoRgixCa6xjnB7YaB1pPB263","limit":1}}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"total":1,"limit":1,"cursor":"8LJRbnDMRBQX8Ggd2jHqN4vvxrfN7Crr9AFqVbnYEKqB","token_accounts":[{"address":"8LJRbnDMRBQX8Ggd2jHqN4vvxrfN7Crr9AFqVbnYEKqB","mint":"DezXAZ8z7PnrnRJjz3wXBoRgixCa6xjnB7YaB1pPB263","owner":"86xCnPeV69n6t3DnyGvkKobf9FdN2H9oiVDdaMpo2MMY","amount":5000,"delegated_amount":0,"frozen":false}]},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewRpcClient(url)
+					return c.GetTokenAccounts(
+						context.TODO(),
+						GetTokenAccountsConfig{
+							Mint:          "DezXAZ8z7PnrnRJjz3wXBoRgixCa6xjnB7YaB1pPB263",
+							DasPagination: DasPagination{Limit: 1},
+						},
+					)
+				},
+				ExpectedValue: JsonRpcResponse[DasTokenAccountList]{
+					JsonRpc: "2.0",
+					Id:      1,
+					Result: DasTokenAccountList{
+						Total:  1,
+						Limit:  1,
+						Cursor: "8LJRbnDMRBQX8Ggd2jHqN4vvxrfN7Crr9AFqVbnYEKqB",
+						Items: []DasTokenAccount{
+							{
+								Address: "8LJRbnDMRBQX8Ggd2jHqN4vvxrfN7Crr9AFqVbnYEKqB",
+								Mint:    "DezXAZ8z7PnrnRJjz3wXBoRgixCa6xjnB7YaB1pPB263",
+								Owner:   "86xCnPeV69n6t3DnyGvkKobf9FdN2H9oiVDdaMpo2MMY",
+								Amount:  5000,
+							},
+						},
+					},
+				},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
@@ -19,6 +19,8 @@ type Asset struct {
 	Mutable     bool              `json:"mutable"`
 	Burnt       bool              `json:"burnt"`
 	TokenInfo   *AssetTokenInfo   `json:"token_info,omitempty"`
+	// MintExtensions are the token-2022 extensions of the asset's mint
+	MintExtensions map[string]interface{} `json:"mint_extensions,omitempty"`
 }
 
 type AssetContent struct {
@@ -31,13 +33,14 @@ type AssetContent struct {
 
 type AssetFile struct {
 	Uri      string        `json:"uri"`
+	CdnUri   string        `json:"cdn_uri,omitempty"`
 	Mime     string        `json:"mime"`
 	Quality  *AssetQuality `json:"quality,omitempty"`
 	Contexts []string      `json:"contexts,omitempty"`
 }
 
 type AssetQuality struct {
-	// Add quality fields as needed
+	Schema string `json:"$$schema"`
 }
 
 type AssetMetadata struct {
@@ -54,8 +57,9 @@ type AssetAttribute struct {
 }
 
 type AssetLinks struct {
-	ExternalUrl string `json:"external_url"`
-	Image       string `json:"image"`
+	ExternalUrl  string `json:"external_url"`
+	Image        string `json:"image"`
+	AnimationUrl string `json:"animation_url,omitempty"`
 }
 
 type AssetAuthority struct {
@@ -77,6 +81,18 @@ type AssetCompression struct {
 type AssetGrouping struct {
 	GroupKey   string `json:"group_key"` // e.g., "collection"
 	GroupValue string `json:"group_value"`
+	// Verified is only returned with the showUnverifiedCollections option
+	Verified *bool `json:"verified,omitempty"`
+	// CollectionMetadata is only returned with the showCollectionMetadata option
+	CollectionMetadata *AssetCollectionMetadata `json:"collection_metadata,omitempty"`
+}
+
+type AssetCollectionMetadata struct {
+	Name        string `json:"name"`
+	Symbol      string `json:"symbol"`
+	Image       string `json:"image"`
+	Description string `json:"description"`
+	ExternalUrl string `json:"external_url"`
 }
 
 type AssetRoyalty struct {
@@ -115,12 +131,14 @@ type AssetSupply struct {
 }
 
 type AssetTokenInfo struct {
-	Supply          int64                  `json:"supply"`
-	Decimals        int                    `json:"decimals"`
-	TokenProgram    string                 `json:"token_program"`
-	MintAuthority   string                 `json:"mint_authority,omitempty"`
-	FreezeAuthority string                 `json:"freeze_authority,omitempty"`
-	MintExtensions  map[string]interface{} `json:"mint_extensions,omitempty"`
+	Symbol                 string `json:"symbol,omitempty"`
+	Balance                *int64 `json:"balance,omitempty"`
+	AssociatedTokenAddress string `json:"associated_token_address,omitempty"`
+	Supply                 int64  `json:"supply"`
+	Decimals               int    `json:"decimals"`
+	TokenProgram           string `json:"token_program"`
+	MintAuthority          string `json:"mint_authority,omitempty"`
+	FreezeAuthority        string `json:"freeze_authority,omitempty"`
 }
 
 func (c *RpcClient) GetAsset(ctx context.Context, assetId string) (JsonRpcResponse[Asset], error) {
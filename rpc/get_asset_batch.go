@@ -0,0 +1,12 @@
+package rpc
+
+import "context"
+
+// GetAssetBatch returns assets in the order of assetIds, an asset which doesn't exist is nil
+func (c *RpcClient) GetAssetBatch(ctx context.Context, assetIds []string) (JsonRpcResponse[[]*Asset], error) {
+	return callWithNamedParams[JsonRpcResponse[[]*Asset]](c, ctx, "getAssetBatch", struct {
+		Ids []string `json:"ids"`
+	}{
+		Ids: assetIds,
+	})
+}
@@ -0,0 +1,20 @@
+package rpc
+
+import "context"
+
+type AssetProof struct {
+	Root      string   `json:"root"`
+	Proof     []string `json:"proof"`
+	NodeIndex int64    `json:"node_index"`
+	Leaf      string   `json:"leaf"`
+	TreeId    string   `json:"tree_id"`
+}
+
+// GetAssetProof returns the merkle proof of a compressed asset
+func (c *RpcClient) GetAssetProof(ctx context.Context, assetId string) (JsonRpcResponse[AssetProof], error) {
+	return callWithNamedParams[JsonRpcResponse[AssetProof]](c, ctx, "getAssetProof", struct {
+		Id string `json:"id"`
+	}{
+		Id: assetId,
+	})
+}
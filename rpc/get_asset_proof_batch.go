@@ -0,0 +1,12 @@
+package rpc
+
+import "context"
+
+// GetAssetProofBatch returns the merkle proofs of compressed assets keyed by asset id
+func (c *RpcClient) GetAssetProofBatch(ctx context.Context, assetIds []string) (JsonRpcResponse[map[string]*AssetProof], error) {
+	return callWithNamedParams[JsonRpcResponse[map[string]*AssetProof]](c, ctx, "getAssetProofBatch", struct {
+		Ids []string `json:"ids"`
+	}{
+		Ids: assetIds,
+	})
+}
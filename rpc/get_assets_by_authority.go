@@ -0,0 +1,19 @@
+package rpc
+
+import "context"
+
+type GetAssetsByAuthorityConfig struct {
+	SortBy *DasSorting `json:"sortBy,omitempty"`
+	DasPagination
+	Options *DasDisplayOptions `json:"options,omitempty"`
+}
+
+func (c *RpcClient) GetAssetsByAuthority(ctx context.Context, authorityAddress string, cfg GetAssetsByAuthorityConfig) (JsonRpcResponse[AssetList], error) {
+	return callWithNamedParams[JsonRpcResponse[AssetList]](c, ctx, "getAssetsByAuthority", struct {
+		AuthorityAddress string `json:"authorityAddress"`
+		GetAssetsByAuthorityConfig
+	}{
+		AuthorityAddress:           authorityAddress,
+		GetAssetsByAuthorityConfig: cfg,
+	})
+}
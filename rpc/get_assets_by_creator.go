@@ -0,0 +1,20 @@
+package rpc
+
+import "context"
+
+type GetAssetsByCreatorConfig struct {
+	OnlyVerified bool        `json:"onlyVerified,omitempty"`
+	SortBy       *DasSorting `json:"sortBy,omitempty"`
+	DasPagination
+	Options *DasDisplayOptions `json:"options,omitempty"`
+}
+
+func (c *RpcClient) GetAssetsByCreator(ctx context.Context, creatorAddress string, cfg GetAssetsByCreatorConfig) (JsonRpcResponse[AssetList], error) {
+	return callWithNamedParams[JsonRpcResponse[AssetList]](c, ctx, "getAssetsByCreator", struct {
+		CreatorAddress string `json:"creatorAddress"`
+		GetAssetsByCreatorConfig
+	}{
+		CreatorAddress:           creatorAddress,
+		GetAssetsByCreatorConfig: cfg,
+	})
+}
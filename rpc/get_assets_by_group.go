@@ -0,0 +1,22 @@
+package rpc
+
+import "context"
+
+type GetAssetsByGroupConfig struct {
+	SortBy *DasSorting `json:"sortBy,omitempty"`
+	DasPagination
+	Options *DasDisplayOptions `json:"options,omitempty"`
+}
+
+// GetAssetsByGroup returns assets of a group, groupKey is "collection" and groupValue the collection mint
+func (c *RpcClient) GetAssetsByGroup(ctx context.Context, groupKey, groupValue string, cfg GetAssetsByGroupConfig) (JsonRpcResponse[AssetList], error) {
+	return callWithNamedParams[JsonRpcResponse[AssetList]](c, ctx, "getAssetsByGroup", struct {
+		GroupKey   string `json:"groupKey"`
+		GroupValue string `json:"groupValue"`
+		GetAssetsByGroupConfig
+	}{
+		GroupKey:               groupKey,
+		GroupValue:             groupValue,
+		GetAssetsByGroupConfig: cfg,
+	})
+}
@@ -0,0 +1,19 @@
+package rpc
+
+import "context"
+
+type GetAssetsByOwnerConfig struct {
+	SortBy *DasSorting `json:"sortBy,omitempty"`
+	DasPagination
+	Options *DasDisplayOptions `json:"options,omitempty"`
+}
+
+func (c *RpcClient) GetAssetsByOwner(ctx context.Context, ownerAddress string, cfg GetAssetsByOwnerConfig) (JsonRpcResponse[AssetList], error) {
+	return callWithNamedParams[JsonRpcResponse[AssetList]](c, ctx, "getAssetsByOwner", struct {
+		OwnerAddress string `json:"ownerAddress"`
+		GetAssetsByOwnerConfig
+	}{
+		OwnerAddress:           ownerAddress,
+		GetAssetsByOwnerConfig: cfg,
+	})
+}
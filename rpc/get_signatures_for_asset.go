@@ -0,0 +1,41 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// AssetSignature is a transaction which touched a compressed asset
+type AssetSignature struct {
+	Signature string
+	Type      string // e.g. "MintToCollectionV1", "Transfer"
+}
+
+// UnmarshalJSON reads the [signature, type] pair the method returns
+func (s *AssetSignature) UnmarshalJSON(data []byte) error {
+	var pair []string
+	if err := json.Unmarshal(data, &pair); err != nil {
+		return err
+	}
+	if len(pair) != 2 {
+		return fmt.Errorf("unexpected asset signature length, got: %v", len(pair))
+	}
+	s.Signature, s.Type = pair[0], pair[1]
+	return nil
+}
+
+type GetSignaturesForAssetConfig struct {
+	DasPagination
+}
+
+// GetSignaturesForAsset returns the transactions of a compressed asset
+func (c *RpcClient) GetSignaturesForAsset(ctx context.Context, assetId string, cfg GetSignaturesForAssetConfig) (JsonRpcResponse[DasPage[AssetSignature]], error) {
+	return callWithNamedParams[JsonRpcResponse[DasPage[AssetSignature]]](c, ctx, "getSignaturesForAsset", struct {
+		Id string `json:"id"`
+		GetSignaturesForAssetConfig
+	}{
+		Id:                          assetId,
+		GetSignaturesForAssetConfig: cfg,
+	})
+}
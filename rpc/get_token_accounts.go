@@ -0,0 +1,44 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+)
+
+type DasTokenAccount struct {
+	Address         string `json:"address"`
+	Mint            string `json:"mint"`
+	Owner           string `json:"owner"`
+	Amount          uint64 `json:"amount"`
+	DelegatedAmount uint64 `json:"delegated_amount"`
+	Frozen          bool   `json:"frozen"`
+}
+
+// DasTokenAccountList is a DasPage whose items are named token_accounts instead of items
+type DasTokenAccountList DasPage[DasTokenAccount]
+
+// UnmarshalJSON reads the page with its items from token_accounts
+func (l *DasTokenAccountList) UnmarshalJSON(data []byte) error {
+	var v struct {
+		DasPage[DasTokenAccount]
+		TokenAccounts []DasTokenAccount `json:"token_accounts"`
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*l = DasTokenAccountList(v.DasPage)
+	l.Items = v.TokenAccounts
+	return nil
+}
+
+// GetTokenAccountsConfig needs at least one of Owner and Mint
+type GetTokenAccountsConfig struct {
+	Owner string `json:"owner,omitempty"`
+	Mint  string `json:"mint,omitempty"`
+	DasPagination
+	Options *DasDisplayOptions `json:"options,omitempty"`
+}
+
+func (c *RpcClient) GetTokenAccounts(ctx context.Context, cfg GetTokenAccountsConfig) (JsonRpcResponse[DasTokenAccountList], error) {
+	return callWithNamedParams[JsonRpcResponse[DasTokenAccountList]](c, ctx, "getTokenAccounts", cfg)
+}
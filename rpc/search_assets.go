@@ -0,0 +1,42 @@
+package rpc
+
+import "context"
+
+type SearchAssetsConditionType string
+
+const (
+	SearchAssetsConditionTypeAll SearchAssetsConditionType = "all"
+	SearchAssetsConditionTypeAny SearchAssetsConditionType = "any"
+)
+
+// SearchAssetsConfig filters assets, unset fields are ignored
+type SearchAssetsConfig struct {
+	// Negate inverts the filters, ConditionType decides whether all or any of them must match
+	Negate            bool                      `json:"negate,omitempty"`
+	ConditionType     SearchAssetsConditionType `json:"conditionType,omitempty"`
+	Interface         string                    `json:"interface,omitempty"`
+	OwnerAddress      string                    `json:"ownerAddress,omitempty"`
+	OwnerType         string                    `json:"ownerType,omitempty"` // "single", "token"
+	CreatorAddress    string                    `json:"creatorAddress,omitempty"`
+	CreatorVerified   *bool                     `json:"creatorVerified,omitempty"`
+	AuthorityAddress  string                    `json:"authorityAddress,omitempty"`
+	Grouping          []string                  `json:"grouping,omitempty"` // [group key, group value]
+	Delegate          string                    `json:"delegate,omitempty"`
+	Frozen            *bool                     `json:"frozen,omitempty"`
+	Supply            *uint64                   `json:"supply,omitempty"`
+	SupplyMint        string                    `json:"supplyMint,omitempty"`
+	Compressed        *bool                     `json:"compressed,omitempty"`
+	Compressible      *bool                     `json:"compressible,omitempty"`
+	RoyaltyTargetType string                    `json:"royaltyTargetType,omitempty"`
+	RoyaltyTarget     string                    `json:"royaltyTarget,omitempty"`
+	RoyaltyAmount     *uint32                   `json:"royaltyAmount,omitempty"`
+	Burnt             *bool                     `json:"burnt,omitempty"`
+	JsonUri           string                    `json:"jsonUri,omitempty"`
+	SortBy            *DasSorting               `json:"sortBy,omitempty"`
+	DasPagination
+	Options *DasDisplayOptions `json:"options,omitempty"`
+}
+
+func (c *RpcClient) SearchAssets(ctx context.Context, cfg SearchAssetsConfig) (JsonRpcResponse[AssetList], error) {
+	return callWithNamedParams[JsonRpcResponse[AssetList]](c, ctx, "searchAssets", cfg)
+}